@@ -0,0 +1,372 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/mdiluz/rove/pkg/certs"
+	"github.com/mdiluz/rove/pkg/glyph"
+	"github.com/mdiluz/rove/pkg/version"
+	"github.com/mdiluz/rove/proto/roveadmin"
+	"github.com/mdiluz/rove/proto/roveapi"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// Command usage
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "Usage: rove-admin ARG [OPT...]")
+	fmt.Fprintf(os.Stderr, "\n")
+	fmt.Fprintln(os.Stderr, "Arguments:")
+	fmt.Fprintln(os.Stderr, "\tversion                       outputs version")
+	fmt.Fprintln(os.Stderr, "\thelp                          outputs this usage text")
+	fmt.Fprintln(os.Stderr, "\taccounts                      lists all accounts")
+	fmt.Fprintln(os.Stderr, "\trovers [ACCOUNT]              lists all rovers, optionally only those owned by an account")
+	fmt.Fprintln(os.Stderr, "\tatlas X Y [RANGE]             prints an area of the atlas in ASCII form")
+	fmt.Fprintln(os.Stderr, "\twarp ROVER X Y                moves a rover to a new position")
+	fmt.Fprintln(os.Stderr, "\tstats ROVER STAT VAL...       sets rover stats, STAT is integrity or charge")
+	fmt.Fprintln(os.Stderr, "\tkill ROVER                    destroys a rover, respawning its owner if left without any")
+	fmt.Fprintln(os.Stderr, "\ttick [COUNT]                  ticks the world immediately")
+	fmt.Fprintln(os.Stderr, "\twind BEARING                  sets the wind direction (N, NE, E, SE, S, SW, W, NW)")
+	fmt.Fprintln(os.Stderr, "\tsave                          saves the world immediately")
+	fmt.Fprintln(os.Stderr, "\texport FILE                   exports a snapshot of the world to a file")
+	fmt.Fprintln(os.Stderr, "\timport FILE                   replaces the world with a snapshot from a file")
+	fmt.Fprintln(os.Stderr, "\tclient-ca CERT KEY            generates a CA for the server to verify client certificates with")
+	fmt.Fprintln(os.Stderr, "\tclient-cert ACCOUNT CA CAKEY CERT KEY")
+	fmt.Fprintln(os.Stderr, "\t                              issues a client certificate for an account, signed by the CA")
+	fmt.Fprintf(os.Stderr, "\n")
+	fmt.Fprintln(os.Stderr, "Environment")
+	fmt.Fprintln(os.Stderr, "\tROVE_ADMIN_HOST       admin server host, defaults to localhost")
+	fmt.Fprintln(os.Stderr, "\tROVE_ADMIN_PORT       admin server port, defaults to 9091")
+	fmt.Fprintln(os.Stderr, "\tROVE_ADMIN_TOKEN      admin token, required")
+	fmt.Fprintln(os.Stderr, "\tROVE_ADMIN_CA_FILE    CA bundle to verify the server with, defaults to the system roots")
+	fmt.Fprintln(os.Stderr, "\tNO_TLS                disables TLS when set")
+}
+
+const (
+	// defaultAdminPort is the default admin server port
+	defaultAdminPort = 9091
+
+	// maxMessageSize is the largest message to send or receive, big enough for world snapshots
+	maxMessageSize = 256 << 20
+)
+
+// atoi converts a numeric argument with a useful error
+func atoi(name string, s string) (int32, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number: %s", name, s)
+	}
+	return int32(i), nil
+}
+
+// writeCertificate writes out a certificate and its private key
+func writeCertificate(certFile string, cert []byte, keyFile string, key []byte) error {
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		return err
+	} else if err := ioutil.WriteFile(certFile, cert, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote certificate to %s and key to %s\n", certFile, keyFile)
+	return nil
+}
+
+// InnerMain wraps the main function so we can test it
+func InnerMain(command string, args ...string) error {
+
+	// Early simple bails
+	switch command {
+	case "help":
+		printUsage()
+		return nil
+	case "version":
+		fmt.Println(version.Version)
+		return nil
+
+	// Certificates are generated locally without the server
+	case "client-ca":
+		if len(args) < 2 {
+			return fmt.Errorf("must pass CERT and KEY to 'client-ca'")
+		}
+
+		cert, key, err := certs.GenerateCA()
+		if err != nil {
+			return err
+		}
+		return writeCertificate(args[0], cert, args[1], key)
+
+	case "client-cert":
+		if len(args) < 5 {
+			return fmt.Errorf("must pass ACCOUNT, CA, CAKEY, CERT and KEY to 'client-cert'")
+		}
+
+		caCert, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			return err
+		}
+		caKey, err := ioutil.ReadFile(args[2])
+		if err != nil {
+			return err
+		}
+		cert, key, err := certs.IssueClientCert(caCert, caKey, args[0])
+		if err != nil {
+			return err
+		}
+		return writeCertificate(args[3], cert, args[4], key)
+	}
+
+	host := os.Getenv("ROVE_ADMIN_HOST")
+	if len(host) == 0 {
+		host = "localhost"
+	}
+	port := os.Getenv("ROVE_ADMIN_PORT")
+	if len(port) == 0 {
+		port = strconv.Itoa(defaultAdminPort)
+	}
+	token := os.Getenv("ROVE_ADMIN_TOKEN")
+	if len(token) == 0 {
+		return fmt.Errorf("ROVE_ADMIN_TOKEN not set")
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)),
+	}
+	if len(os.Getenv("NO_TLS")) == 0 {
+		tlsConfig, err := certs.ClientConfig(os.Getenv("ROVE_ADMIN_CA_FILE"), "", "")
+		if err != nil {
+			return fmt.Errorf("failed to set up TLS: %s", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	// Set up the server
+	clientConn, err := grpc.Dial(fmt.Sprintf("%s:%s", host, port), opts...)
+	if err != nil {
+		return err
+	}
+	var client = roveadmin.NewRoveAdminClient(clientConn)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Authenticate all requests with the admin token
+	ctx = metadata.AppendToOutgoingContext(ctx, "rove-admin-token", token)
+
+	// Handle all the commands
+	switch command {
+	case "accounts":
+		response, err := client.ListAccounts(ctx, &roveadmin.ListAccountsRequest{})
+		if err != nil {
+			return err
+		}
+
+		for _, a := range response.Accounts {
+			fmt.Printf("%s\tteam: %s\tactive: %s\trovers: %s\n", a.Name, a.Team, a.Active, strings.Join(a.Rovers, ", "))
+		}
+
+	case "rovers":
+		req := &roveadmin.ListRoversRequest{}
+		if len(args) > 0 {
+			req.Account = args[0]
+		}
+
+		response, err := client.ListRovers(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range response.Rovers {
+			fmt.Printf("%s\towner: %s\tposition: %d,%d\tintegrity: %d/%d\tcharge: %d/%d\n",
+				r.Name, r.Owner, r.Position.X, r.Position.Y, r.Integrity, r.MaximumIntegrity, r.Charge, r.MaximumCharge)
+		}
+
+	case "atlas":
+		if len(args) < 2 {
+			return fmt.Errorf("must pass X and Y to 'atlas'")
+		}
+
+		req := &roveadmin.AtlasRequest{Centre: &roveapi.Vector{}, Range: 10}
+		if req.Centre.X, err = atoi("X", args[0]); err != nil {
+			return err
+		} else if req.Centre.Y, err = atoi("Y", args[1]); err != nil {
+			return err
+		} else if len(args) > 2 {
+			if req.Range, err = atoi("RANGE", args[2]); err != nil {
+				return err
+			}
+		}
+
+		response, err := client.Atlas(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		// Print out the area, the same way as the radar
+		num := int(math.Sqrt(float64(len(response.Tiles))))
+		for j := num - 1; j >= 0; j-- {
+			for i := 0; i < num; i++ {
+				t := response.Tiles[i+num*j]
+				o := response.Objects[i+num*j]
+				if o != roveapi.Object_ObjectUnknown {
+					fmt.Printf("%c", glyph.ObjectGlyph(o))
+				} else {
+					fmt.Printf("%c", glyph.TileGlyph(t))
+				}
+			}
+			fmt.Print("\n")
+		}
+
+	case "warp":
+		if len(args) < 3 {
+			return fmt.Errorf("must pass ROVER, X and Y to 'warp'")
+		}
+
+		req := &roveadmin.WarpRoverRequest{Rover: args[0], Position: &roveapi.Vector{}}
+		if req.Position.X, err = atoi("X", args[1]); err != nil {
+			return err
+		} else if req.Position.Y, err = atoi("Y", args[2]); err != nil {
+			return err
+		}
+
+		if _, err := client.WarpRover(ctx, req); err != nil {
+			return err
+		}
+		fmt.Printf("Warped %s to %d,%d\n", args[0], req.Position.X, req.Position.Y)
+
+	case "stats":
+		if len(args) < 3 || len(args)%2 != 1 {
+			return fmt.Errorf("must pass ROVER and pairs of STAT VAL to 'stats'")
+		}
+
+		req := &roveadmin.SetRoverStatsRequest{Rover: args[0]}
+		for i := 1; i < len(args); i += 2 {
+			val, err := atoi(args[i], args[i+1])
+			if err != nil {
+				return err
+			}
+
+			switch args[i] {
+			case "integrity":
+				req.Integrity = &wrappers.Int32Value{Value: val}
+			case "charge":
+				req.Charge = &wrappers.Int32Value{Value: val}
+			default:
+				return fmt.Errorf("unknown rover stat: %s", args[i])
+			}
+		}
+
+		response, err := client.SetRoverStats(ctx, req)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\tintegrity: %d/%d\tcharge: %d/%d\n", response.Rover.Name,
+			response.Rover.Integrity, response.Rover.MaximumIntegrity, response.Rover.Charge, response.Rover.MaximumCharge)
+
+	case "kill":
+		if len(args) == 0 {
+			return fmt.Errorf("must pass ROVER to 'kill'")
+		}
+
+		response, err := client.KillRover(ctx, &roveadmin.KillRoverRequest{Rover: args[0]})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Killed %s\n", args[0])
+		if len(response.Respawned) > 0 {
+			fmt.Printf("Respawned as %s\n", response.Respawned)
+		}
+
+	case "tick":
+		req := &roveadmin.ForceTickRequest{}
+		if len(args) > 0 {
+			if req.Ticks, err = atoi("COUNT", args[0]); err != nil {
+				return err
+			}
+		}
+
+		response, err := client.ForceTick(ctx, req)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Current Tick: %d\n", response.CurrentTick)
+
+	case "wind":
+		if len(args) == 0 {
+			return fmt.Errorf("must pass BEARING to 'wind'")
+		}
+
+		b := glyph.BearingFromString(args[0])
+		if b == roveapi.Bearing_BearingUnknown {
+			return fmt.Errorf("unknown bearing: %s", args[0])
+		}
+
+		if _, err := client.SetWind(ctx, &roveadmin.SetWindRequest{Wind: b}); err != nil {
+			return err
+		}
+		fmt.Printf("Wind set to %s\n", b)
+
+	case "save":
+		if _, err := client.SaveNow(ctx, &roveadmin.SaveNowRequest{}); err != nil {
+			return err
+		}
+		fmt.Println("Saved world")
+
+	case "export":
+		if len(args) == 0 {
+			return fmt.Errorf("must pass FILE to 'export'")
+		}
+
+		response, err := client.ExportWorld(ctx, &roveadmin.ExportWorldRequest{})
+		if err != nil {
+			return err
+		} else if err := ioutil.WriteFile(args[0], response.Snapshot, 0644); err != nil {
+			return err
+		}
+		fmt.Printf("Exported world to %s\n", args[0])
+
+	case "import":
+		if len(args) == 0 {
+			return fmt.Errorf("must pass FILE to 'import'")
+		}
+
+		snapshot, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			return err
+		} else if _, err := client.ImportWorld(ctx, &roveadmin.ImportWorldRequest{Snapshot: snapshot}); err != nil {
+			return err
+		}
+		fmt.Printf("Imported world from %s\n", args[0])
+
+	default:
+		// Print the usage
+		fmt.Fprintf(os.Stderr, "Error: unknown command %s\n", command)
+		printUsage()
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+// Simple main
+func main() {
+	// Bail without any args
+	if len(os.Args) == 1 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	// Run the inner main
+	if err := InnerMain(os.Args[1], os.Args[2:]...); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,68 @@
+// +build integration
+
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InnerMain(t *testing.T) {
+	os.Setenv("NO_TLS", "1")
+
+	// Used for configuring this test
+	var address = os.Getenv("ROVE_GRPC")
+	if len(address) == 0 {
+		log.Fatal("Must set $ROVE_GRPC")
+	}
+	os.Setenv("ROVE_ADMIN_HOST", address)
+
+	// Everything should fail without the right token
+	os.Setenv("ROVE_ADMIN_TOKEN", "")
+	assert.Error(t, InnerMain("accounts"))
+	os.Setenv("ROVE_ADMIN_TOKEN", "wrong")
+	assert.Error(t, InnerMain("accounts"))
+
+	var token = os.Getenv("ROVE_TEST_ADMIN_TOKEN")
+	if len(token) == 0 {
+		log.Fatal("Must set $ROVE_TEST_ADMIN_TOKEN")
+	}
+	os.Setenv("ROVE_ADMIN_TOKEN", token)
+
+	assert.NoError(t, InnerMain("accounts"))
+	assert.NoError(t, InnerMain("rovers"))
+	assert.NoError(t, InnerMain("atlas", "0", "0"))
+	assert.NoError(t, InnerMain("atlas", "0", "0", "5"))
+	assert.Error(t, InnerMain("atlas", "0"))
+	assert.Error(t, InnerMain("atlas", "x", "0"))
+
+	assert.Error(t, InnerMain("warp", "unknown", "0", "0"))
+	assert.Error(t, InnerMain("stats", "unknown", "charge", "1"))
+	assert.Error(t, InnerMain("kill", "unknown"))
+
+	assert.NoError(t, InnerMain("tick"))
+	assert.NoError(t, InnerMain("tick", "2"))
+	assert.Error(t, InnerMain("tick", "-1"))
+
+	assert.NoError(t, InnerMain("wind", "SW"))
+	assert.Error(t, InnerMain("wind", "up"))
+
+	assert.NoError(t, InnerMain("save"))
+
+	// Export the world, importing over the shared test server is left to the unit tests
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-admin-")
+	assert.NoError(t, err)
+	snapshot := path.Join(tmp, "world.json")
+	assert.NoError(t, InnerMain("export", snapshot))
+	assert.Error(t, InnerMain("import", path.Join(tmp, "missing.json")))
+
+	// Bad snapshots should be rejected
+	bad := path.Join(tmp, "bad.json")
+	assert.NoError(t, ioutil.WriteFile(bad, []byte("{"), 0644))
+	assert.Error(t, InnerMain("import", bad))
+}
@@ -0,0 +1,251 @@
+package internal
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/mdiluz/rove/pkg/maths"
+	"github.com/mdiluz/rove/pkg/rove"
+	"github.com/mdiluz/rove/proto/roveadmin"
+	"github.com/mdiluz/rove/proto/roveapi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// adminTokenMetadataKey is the metadata key for the admin token
+	adminTokenMetadataKey = "rove-admin-token"
+
+	// maxForcedTicks is the most ticks a single ForceTick request can run
+	maxForcedTicks = 100
+
+	// maxAuditLength is the longest request description written to the audit log
+	maxAuditLength = 256
+
+	// maxAdminMessageSize is the largest admin message, big enough for world snapshots
+	maxAdminMessageSize = 256 << 20
+)
+
+// AdminServer serves the admin service for a server
+type AdminServer struct {
+	server *Server
+}
+
+// adminAuthInterceptor rejects admin requests without the admin token
+func (s *Server) adminAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	token := firstValue(md, adminTokenMetadataKey)
+	if len(token) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing admin token")
+	} else if subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) != 1 {
+		return nil, status.Error(codes.PermissionDenied, "invalid admin token")
+	}
+	return handler(ctx, req)
+}
+
+// adminAuditInterceptor logs every admin call along with who made it and how it went
+func (s *Server) adminAuditInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	caller := "unknown"
+	if p, ok := peer.FromContext(ctx); ok {
+		caller = p.Addr.String()
+	}
+
+	// Keep large requests such as world snapshots out of the log
+	desc := fmt.Sprintf("%v", req)
+	if len(desc) > maxAuditLength {
+		desc = desc[:maxAuditLength] + "..."
+	}
+
+	resp, err := handler(ctx, req)
+	s.adminLog.Info("Admin audit", "method", info.FullMethod, "caller", caller, "request", desc, "code", status.Code(err))
+	return resp, err
+}
+
+// ListAccounts lists all accounts for an admin request
+func (a *AdminServer) ListAccounts(ctx context.Context, req *roveadmin.ListAccountsRequest) (*roveadmin.ListAccountsResponse, error) {
+	w := a.server.world
+
+	response := &roveadmin.ListAccountsResponse{}
+	for _, account := range w.Accountant.ListAccounts() {
+		active, err := w.Accountant.GetValue(account, activeKey)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Accounts = append(response.Accounts, &roveadmin.AccountInfo{
+			Name:   account,
+			Team:   w.Teams.TeamOf(account),
+			Rovers: w.Fleet(account),
+			Active: active,
+		})
+	}
+	return response, nil
+}
+
+// ListRovers lists rovers for an admin request
+func (a *AdminServer) ListRovers(ctx context.Context, req *roveadmin.ListRoversRequest) (*roveadmin.ListRoversResponse, error) {
+	response := &roveadmin.ListRoversResponse{}
+	for _, r := range a.server.world.ListRovers(req.Account) {
+		response.Rovers = append(response.Rovers, roverInfo(r))
+	}
+	return response, nil
+}
+
+// WarpRover moves a rover for an admin request
+func (a *AdminServer) WarpRover(ctx context.Context, req *roveadmin.WarpRoverRequest) (*roveadmin.WarpRoverResponse, error) {
+	if req.Position == nil {
+		return nil, fmt.Errorf("%w: no position given", rove.ErrInvalidArgument)
+	}
+
+	pos := maths.Vector{X: int(req.Position.X), Y: int(req.Position.Y)}
+	if err := a.server.world.WarpRover(req.Rover, pos); err != nil {
+		return nil, err
+	}
+	return &roveadmin.WarpRoverResponse{}, nil
+}
+
+// SetRoverStats sets rover stats for an admin request
+func (a *AdminServer) SetRoverStats(ctx context.Context, req *roveadmin.SetRoverStatsRequest) (*roveadmin.SetRoverStatsResponse, error) {
+	var integrity, charge *int
+	if req.Integrity != nil {
+		v := int(req.Integrity.Value)
+		integrity = &v
+	}
+	if req.Charge != nil {
+		v := int(req.Charge.Value)
+		charge = &v
+	}
+
+	r, err := a.server.world.SetRoverStats(req.Rover, integrity, charge)
+	if err != nil {
+		return nil, err
+	}
+	return &roveadmin.SetRoverStatsResponse{Rover: roverInfo(r)}, nil
+}
+
+// ForceTick ticks the world for an admin request
+func (a *AdminServer) ForceTick(ctx context.Context, req *roveadmin.ForceTickRequest) (*roveadmin.ForceTickResponse, error) {
+	ticks := int(req.Ticks)
+	if ticks == 0 {
+		ticks = 1
+	} else if ticks < 0 || ticks > maxForcedTicks {
+		return nil, fmt.Errorf("%w: ticks must be between 1 and %d", rove.ErrInvalidArgument, maxForcedTicks)
+	}
+
+	if a.server.isDraining() {
+		return nil, errShuttingDown
+	}
+
+	for i := 0; i < ticks; i++ {
+		a.server.tick()
+	}
+
+	if err := a.server.SaveWorld(); err != nil {
+		return nil, err
+	}
+
+	return &roveadmin.ForceTickResponse{CurrentTick: int32(a.server.world.CurrentTicks)}, nil
+}
+
+// SetWind sets the wind for an admin request
+func (a *AdminServer) SetWind(ctx context.Context, req *roveadmin.SetWindRequest) (*roveadmin.SetWindResponse, error) {
+	if err := a.server.world.SetWind(req.Wind); err != nil {
+		return nil, err
+	}
+	return &roveadmin.SetWindResponse{}, nil
+}
+
+// KillRover destroys a rover, respawning its owner if left without any, for an admin request
+func (a *AdminServer) KillRover(ctx context.Context, req *roveadmin.KillRoverRequest) (*roveadmin.KillRoverResponse, error) {
+	w := a.server.world
+
+	r, err := w.GetRover(req.Rover)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clear out any queued commands then destroy the rover
+	if err := w.Enqueue(r.Name); err != nil {
+		return nil, err
+	} else if err := w.DestroyRover(r.Name); err != nil {
+		return nil, err
+	}
+
+	// Only respawn if that was the last of the owner's fleet
+	response := &roveadmin.KillRoverResponse{}
+	if len(r.Owner) > 0 {
+		if response.Respawned, err = w.EnsureFleet(r.Owner); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// SaveNow saves the world for an admin request
+func (a *AdminServer) SaveNow(ctx context.Context, req *roveadmin.SaveNowRequest) (*roveadmin.SaveNowResponse, error) {
+	if err := a.server.SaveWorld(); err != nil {
+		return nil, err
+	}
+	return &roveadmin.SaveNowResponse{}, nil
+}
+
+// roverInfo describes a rover for the admin service
+func roverInfo(r rove.Rover) *roveadmin.RoverInfo {
+	return &roveadmin.RoverInfo{
+		Name:             r.Name,
+		Owner:            r.Owner,
+		Position:         &roveapi.Vector{X: int32(r.Pos.X), Y: int32(r.Pos.Y)},
+		Integrity:        int32(r.Integrity),
+		MaximumIntegrity: int32(r.MaximumIntegrity),
+		Charge:           int32(r.Charge),
+		MaximumCharge:    int32(r.MaximumCharge),
+	}
+}
+
+// Atlas queries an area of the world for an admin request
+func (a *AdminServer) Atlas(ctx context.Context, req *roveadmin.AtlasRequest) (*roveadmin.AtlasResponse, error) {
+	var centre maths.Vector
+	if req.Centre != nil {
+		centre = maths.Vector{X: int(req.Centre.X), Y: int(req.Centre.Y)}
+	}
+
+	tiles, objs, err := a.server.world.AtlasArea(centre, int(req.Range))
+	if err != nil {
+		return nil, err
+	}
+
+	return &roveadmin.AtlasResponse{
+		Range:   req.Range,
+		Tiles:   tiles,
+		Objects: objs,
+	}, nil
+}
+
+// ExportWorld returns a snapshot of the world for an admin request
+func (a *AdminServer) ExportWorld(ctx context.Context, req *roveadmin.ExportWorldRequest) (*roveadmin.ExportWorldResponse, error) {
+	snapshot, err := a.server.world.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &roveadmin.ExportWorldResponse{Snapshot: snapshot}, nil
+}
+
+// ImportWorld replaces the world with a snapshot for an admin request
+func (a *AdminServer) ImportWorld(ctx context.Context, req *roveadmin.ImportWorldRequest) (*roveadmin.ImportWorldResponse, error) {
+	if err := a.server.world.Restore(req.Snapshot); err != nil {
+		return nil, err
+	}
+
+	// The configured options take priority over the imported ones, and sessions may be for accounts that no longer exist
+	a.server.applyWorldOptions()
+	a.server.sessions.clear()
+
+	if err := a.server.SaveWorld(); err != nil {
+		return nil, err
+	}
+	return &roveadmin.ImportWorldResponse{}, nil
+}
@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/mdiluz/rove/pkg/certs"
+	"github.com/mdiluz/rove/proto/roveadmin"
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_AdminAuth(t *testing.T) {
+	s := NewServer(OptionAdmin("", "letmein"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/roveadmin.RoveAdmin/SaveNow"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	_, err := s.adminAuthInterceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(adminTokenMetadataKey, "wrong"))
+	_, err = s.adminAuthInterceptor(ctx, nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(adminTokenMetadataKey, "letmein"))
+	resp, err := s.adminAuthInterceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestServer_AdminWithClientCerts(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-admin-tls-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	certFile, keyFile, caFile := path.Join(tmp, "cert.pem"), path.Join(tmp, "key.pem"), path.Join(tmp, "ca.pem")
+	assert.NoError(t, certs.EnsureSelfSigned(certFile, keyFile, certs.LocalHosts))
+	ca, _, err := certs.GenerateCA()
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(caFile, ca, 0600))
+
+	s := NewServer(
+		OptionAddress("localhost:0"),
+		OptionTLS(certFile, keyFile),
+		OptionClientCA(caFile, true),
+		OptionAdmin("localhost:0", "letmein"))
+	assert.NoError(t, s.Initialise(true))
+	go s.Run()
+	defer s.StopAndClose()
+
+	config, err := certs.ClientConfig(certFile, "", "")
+	assert.NoError(t, err)
+	dial := func(address string) *grpc.ClientConn {
+		conn, err := grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+		assert.NoError(t, err)
+		return conn
+	}
+
+	// The game needs a client certificate
+	game := dial(s.netListener.Addr().String())
+	defer game.Close()
+	_, err = roveapi.NewRoveClient(game).ServerStatus(context.Background(), &roveapi.ServerStatusRequest{})
+	assert.Error(t, err)
+
+	// The admin service only needs its token
+	admin := dial(s.adminListener.Addr().String())
+	defer admin.Close()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), adminTokenMetadataKey, "letmein")
+	_, err = roveadmin.NewRoveAdminClient(admin).ListAccounts(ctx, &roveadmin.ListAccountsRequest{})
+	assert.NoError(t, err)
+}
+
+func TestAdminServer_Rovers(t *testing.T) {
+	s := NewServer()
+	a := &AdminServer{server: s}
+	_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+	rover := s.world.Fleet("test")[0]
+
+	accs, err := a.ListAccounts(context.Background(), &roveadmin.ListAccountsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, accs.Accounts, 1)
+	assert.Equal(t, []string{rover}, accs.Accounts[0].Rovers)
+
+	rovers, err := a.ListRovers(context.Background(), &roveadmin.ListRoversRequest{Account: "test"})
+	assert.NoError(t, err)
+	assert.Len(t, rovers.Rovers, 1)
+	rovers, err = a.ListRovers(context.Background(), &roveadmin.ListRoversRequest{Account: "nobody"})
+	assert.NoError(t, err)
+	assert.Empty(t, rovers.Rovers)
+
+	_, err = a.WarpRover(context.Background(), &roveadmin.WarpRoverRequest{Rover: rover, Position: &roveapi.Vector{X: 100, Y: 100}})
+	assert.NoError(t, err)
+	r, err := s.world.GetRover(rover)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, r.Pos.X)
+
+	// Stats are clamped and unset stats are left alone
+	stats, err := a.SetRoverStats(context.Background(), &roveadmin.SetRoverStatsRequest{Rover: rover, Integrity: &wrappers.Int32Value{Value: 1000}})
+	assert.NoError(t, err)
+	assert.Equal(t, stats.Rover.MaximumIntegrity, stats.Rover.Integrity)
+	assert.Equal(t, int32(r.Charge), stats.Rover.Charge)
+
+	// Killing one of several rovers leaves the rest of the fleet alone
+	other, err := s.world.SpawnRover("test")
+	assert.NoError(t, err)
+	killed, err := a.KillRover(context.Background(), &roveadmin.KillRoverRequest{Rover: other})
+	assert.NoError(t, err)
+	assert.Empty(t, killed.Respawned)
+	assert.Equal(t, []string{rover}, s.world.Fleet("test"))
+
+	// The last rover is replaced for its owner
+	killed, err = a.KillRover(context.Background(), &roveadmin.KillRoverRequest{Rover: rover})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, killed.Respawned)
+	assert.Equal(t, []string{killed.Respawned}, s.world.Fleet("test"))
+	_, err = a.KillRover(context.Background(), &roveadmin.KillRoverRequest{Rover: rover})
+	assert.Error(t, err)
+}
+
+func TestAdminServer_World(t *testing.T) {
+	s := NewServer()
+	a := &AdminServer{server: s}
+
+	resp, err := a.ForceTick(context.Background(), &roveadmin.ForceTickRequest{Ticks: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.CurrentTick)
+	_, err = a.ForceTick(context.Background(), &roveadmin.ForceTickRequest{Ticks: -1})
+	assert.Error(t, err)
+
+	_, err = a.SetWind(context.Background(), &roveadmin.SetWindRequest{Wind: roveapi.Bearing_SouthWest})
+	assert.NoError(t, err)
+	assert.Equal(t, roveapi.Bearing_SouthWest, s.world.Wind)
+	_, err = a.SetWind(context.Background(), &roveadmin.SetWindRequest{})
+	assert.Error(t, err)
+
+	_, err = a.SaveNow(context.Background(), &roveadmin.SaveNowRequest{})
+	assert.NoError(t, err)
+}
+
+func TestAdminServer_Snapshots(t *testing.T) {
+	s := NewServer(OptionFleetCap(3))
+	a := &AdminServer{server: s}
+	_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+
+	atlas, err := a.Atlas(context.Background(), &roveadmin.AtlasRequest{Range: 20})
+	assert.NoError(t, err)
+	assert.Len(t, atlas.Tiles, 41*41)
+	assert.Contains(t, atlas.Objects, roveapi.Object_RoverLive)
+
+	exported, err := a.ExportWorld(context.Background(), &roveadmin.ExportWorldRequest{})
+	assert.NoError(t, err)
+
+	// Importing brings back the exported world while keeping the configured fleet cap
+	s.world.FleetCap = 1
+	assert.NoError(t, s.deleteAccount("test"))
+	_, err = a.ImportWorld(context.Background(), &roveadmin.ImportWorldRequest{Snapshot: exported.Snapshot})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test"}, s.world.Accountant.ListAccounts())
+	assert.Len(t, s.world.Fleet("test"), 1)
+	assert.Equal(t, 3, s.world.FleetCap)
+
+	_, err = a.ImportWorld(context.Background(), &roveadmin.ImportWorldRequest{Snapshot: []byte("{")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(errorStatus(err)))
+}
@@ -0,0 +1,222 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// accountMetadataKey is the metadata key for the account name
+	accountMetadataKey = "rove-account"
+
+	// secretMetadataKey is the metadata key for the account secret
+	secretMetadataKey = "rove-secret"
+
+	// tokenMetadataKey is the metadata key for a session token
+	tokenMetadataKey = "rove-token"
+
+	// sessionLifetime is how long a session token stays valid
+	sessionLifetime = time.Hour
+)
+
+// unauthenticatedMethods are the methods that can be called without credentials
+var unauthenticatedMethods = map[string]bool{
+	"/roveapi.Rove/ServerStatus": true,
+	"/roveapi.Rove/Register":     true,
+
+	// Spectators have no accounts, and may need a spectator token instead
+	"/roveapi.Rove/Spectate": true,
+
+	// Health checks come from deployment tooling without accounts
+	"/grpc.health.v1.Health/Check": true,
+}
+
+// accountKey is the context key for the authenticated account
+type accountKey struct{}
+
+// session describes a logged in account
+type session struct {
+	account string
+	expires time.Time
+}
+
+// sessions tracks the current session tokens
+type sessions struct {
+	tokens map[string]session
+	mutex  sync.Mutex
+}
+
+// start creates a new session token for an account
+func (s *sessions) start(account string) (string, time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.tokens == nil {
+		s.tokens = make(map[string]session)
+	}
+
+	// Clean out any expired sessions while we're here
+	now := time.Now()
+	for t, sess := range s.tokens {
+		if now.After(sess.expires) {
+			delete(s.tokens, t)
+		}
+	}
+
+	token := uuid.New().String()
+	expires := now.Add(sessionLifetime)
+	s.tokens[token] = session{account: account, expires: expires}
+	return token, expires
+}
+
+// find returns the account for a valid session token
+func (s *sessions) find(token string) (string, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sess, ok := s.tokens[token]
+	if !ok {
+		return "", false
+	} else if time.Now().After(sess.expires) {
+		delete(s.tokens, token)
+		return "", false
+	}
+	return sess.account, true
+}
+
+// end removes all sessions for an account
+func (s *sessions) end(account string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for t, sess := range s.tokens {
+		if sess.account == account {
+			delete(s.tokens, t)
+		}
+	}
+}
+
+// clear removes all sessions
+func (s *sessions) clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.tokens = nil
+}
+
+// authInterceptor authenticates requests and places the account on the context
+func (s *Server) authInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if unauthenticatedMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	account, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recordActivity(account); err != nil {
+		return nil, err
+	}
+
+	// Returning accounts whose fleet was retired get a fresh rover
+	if info.FullMethod != "/roveapi.Rove/DeleteAccount" {
+		if _, err := s.world.EnsureFleet(account); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx = context.WithValue(ctx, accountKey{}, account)
+	return handler(ctx, req)
+}
+
+// authenticate finds the account for the credentials in the request metadata
+func (s *Server) authenticate(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	// Prefer a session token if there is one
+	if token := firstValue(md, tokenMetadataKey); len(token) > 0 {
+		account, ok := s.sessions.find(token)
+		if !ok {
+			return "", status.Error(codes.Unauthenticated, "invalid or expired session token")
+		}
+		return account, nil
+	}
+
+	// Then a verified client certificate
+	if account, ok := certificateAccount(ctx); ok {
+		if _, err := s.world.Accountant.GetValue(account, activeKey); err != nil {
+			return "", status.Errorf(codes.Unauthenticated, "no account %s for client certificate", account)
+		}
+		return account, nil
+	}
+
+	account := firstValue(md, accountMetadataKey)
+	secret := firstValue(md, secretMetadataKey)
+	if len(account) == 0 || len(secret) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing account credentials")
+	}
+
+	if valid, err := s.world.Accountant.VerifySecret(account, secret); err != nil || !valid {
+		return "", status.Errorf(codes.Unauthenticated, "invalid credentials for account %s", account)
+	}
+
+	return account, nil
+}
+
+// certificateAccount returns the account named by a verified client certificate, if the client presented one
+func certificateAccount(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 {
+		return "", false
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName, true
+}
+
+// firstValue returns the first metadata value for a key
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// accountFromContext returns the authenticated account
+func accountFromContext(ctx context.Context) string {
+	account, _ := ctx.Value(accountKey{}).(string)
+	return account
+}
+
+// ownedRover returns the named rover if the authenticated account owns it, or the first rover in the fleet if no name is given
+func (s *Server) ownedRover(ctx context.Context, name string) (string, error) {
+	account := accountFromContext(ctx)
+
+	if len(name) == 0 {
+		fleet := s.world.Fleet(account)
+		if len(fleet) == 0 {
+			return "", status.Errorf(codes.NotFound, "no rovers in fleet for account %s", account)
+		}
+		return fleet[0], nil
+	}
+
+	rover, err := s.world.GetRover(name)
+	if err != nil {
+		return "", err
+	} else if rover.Owner != account {
+		return "", status.Errorf(codes.PermissionDenied, "rover %s is not owned by account %s", name, account)
+	}
+	return name, nil
+}
@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// callAuthenticated runs a method through the auth interceptor with the given metadata
+func callAuthenticated(s *Server, method string, kv ...string) (account string, err error) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	_, err = s.authInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		account = accountFromContext(ctx)
+		return nil, nil
+	})
+	return
+}
+
+func TestServer_AuthInterceptor(t *testing.T) {
+	s := NewServer()
+	reg, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+	secret := reg.Account.Secret
+
+	// Unauthenticated methods need no credentials
+	_, err = callAuthenticated(s, "/roveapi.Rove/ServerStatus")
+	assert.NoError(t, err)
+
+	// Others are rejected without valid credentials
+	_, err = callAuthenticated(s, "/roveapi.Rove/Status")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = callAuthenticated(s, "/roveapi.Rove/Status", accountMetadataKey, "test", secretMetadataKey, "wrong")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = callAuthenticated(s, "/roveapi.Rove/Status", accountMetadataKey, "unknown", secretMetadataKey, secret)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Valid credentials resolve the account
+	account, err := callAuthenticated(s, "/roveapi.Rove/Status", accountMetadataKey, "test", secretMetadataKey, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", account)
+
+	// Log in and use the session token instead
+	login, err := s.Login(context.WithValue(context.Background(), accountKey{}, "test"), &roveapi.LoginRequest{})
+	assert.NoError(t, err)
+	account, err = callAuthenticated(s, "/roveapi.Rove/Status", tokenMetadataKey, login.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", account)
+
+	_, err = callAuthenticated(s, "/roveapi.Rove/Status", tokenMetadataKey, "invalid")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Rotating the secret ends all sessions
+	_, err = s.RotateSecret(context.WithValue(context.Background(), accountKey{}, "test"), &roveapi.RotateSecretRequest{})
+	assert.NoError(t, err)
+	_, err = callAuthenticated(s, "/roveapi.Rove/Status", tokenMetadataKey, login.Token)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestServer_AuthClientCertificate(t *testing.T) {
+	s := NewServer()
+	_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+
+	// certificateContext builds a context for a client that presented a verified certificate
+	certificateContext := func(account string) context.Context {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: account}}
+		info := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info})
+	}
+
+	call := func(ctx context.Context) (account string, err error) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/roveapi.Rove/Status"}
+		_, err = s.authInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			account = accountFromContext(ctx)
+			return nil, nil
+		})
+		return
+	}
+
+	// The certificate authenticates the account it names without a secret
+	account, err := call(certificateContext("test"))
+	assert.NoError(t, err)
+	assert.Equal(t, "test", account)
+
+	_, err = call(certificateContext("nobody"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Unverified certificates are ignored
+	info := credentials.TLSInfo{State: tls.ConnectionState{}}
+	_, err = call(peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info}))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,516 @@
+package internal
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mdiluz/rove/pkg/logging"
+	"github.com/mdiluz/rove/pkg/rove"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// StorageFile stores the world in JSON files under the storage path
+	StorageFile = "file"
+
+	// StorageMemory keeps the world in memory only
+	StorageMemory = "memory"
+)
+
+// Config describes everything needed to run a server
+type Config struct {
+	// Address is the address to serve the game on
+	Address string `yaml:"address"`
+
+	// ShutdownTimeout is how long in-flight requests get to finish when the server stops
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+
+	// Admin configures the admin service
+	Admin struct {
+		// Address is the address to serve the admin service on
+		Address string `yaml:"address"`
+
+		// Token guards the admin service, which is disabled without one
+		Token string `yaml:"token"`
+	} `yaml:"admin"`
+
+	// Metrics configures the Prometheus metrics endpoint
+	Metrics struct {
+		// Address is the address to serve metrics over HTTP on, metrics aren't served without one
+		Address string `yaml:"address"`
+	} `yaml:"metrics"`
+
+	// Logging configures what the server logs and how
+	Logging struct {
+		// Level is the minimum level logged, one of debug, info, warn or error
+		Level string `yaml:"level"`
+
+		// Format is either "text" or "json"
+		Format string `yaml:"format"`
+
+		// Subsystems overrides the level for any of the server, rpc, admin, world and persistence subsystems
+		Subsystems subsystemLevels `yaml:"subsystems"`
+	} `yaml:"logging"`
+
+	// Spectate configures the public view of the world
+	Spectate struct {
+		// Token is needed to spectate, anyone can spectate without one
+		Token string `yaml:"token"`
+
+		// Rovers is which rovers spectators see, "all", "opt-in" for public accounts only, or "none"
+		Rovers string `yaml:"rovers"`
+	} `yaml:"spectate"`
+
+	// RateLimits configures how often clients can call each RPC
+	RateLimits RateLimits `yaml:"rate_limits"`
+
+	// Storage configures where the world is kept
+	Storage struct {
+		// Backend is either "file" or "memory"
+		Backend string `yaml:"backend"`
+
+		// Path is the directory to store files in for the file backend
+		Path string `yaml:"path"`
+	} `yaml:"storage"`
+
+	// TLS configures transport security
+	TLS struct {
+		// Disabled serves without TLS
+		Disabled bool `yaml:"disabled"`
+
+		// CertFile and KeyFile are the certificate chain and private key
+		CertFile string `yaml:"cert_file"`
+		KeyFile  string `yaml:"key_file"`
+
+		// SelfSigned generates a self-signed certificate for local play if the files don't exist
+		// They default to files under the storage path
+		SelfSigned bool `yaml:"self_signed"`
+
+		// ClientCAFile enables mutual TLS, client certificates signed by it authenticate the account in their common name
+		ClientCAFile string `yaml:"client_ca_file"`
+
+		// RequireClientCert rejects clients without a valid certificate
+		RequireClientCert bool `yaml:"require_client_cert"`
+	} `yaml:"tls"`
+
+	// Tick configures when the world ticks
+	Tick struct {
+		// Schedule is "manual", a duration such as "30s" or a standard cron expression
+		Schedule string `yaml:"schedule"`
+	} `yaml:"tick"`
+
+	// World configures the world and its gameplay
+	World struct {
+		// Seed is the generation seed for new worlds
+		Seed int64 `yaml:"seed"`
+
+		// ChunkSize is the size of the atlas chunks for new worlds
+		ChunkSize int `yaml:"chunk_size"`
+
+		// TicksPerDay is the number of ticks in a day
+		TicksPerDay int `yaml:"ticks_per_day"`
+
+		// RulesFile is a YAML file of gameplay rules, the world keeps its own rules without one
+		RulesFile string `yaml:"rules_file"`
+
+		// RecipesFile is a YAML file of crafting recipes, the world keeps its own recipes without one
+		RecipesFile string `yaml:"recipes_file"`
+
+		// WordsFile is a file of words to name rovers with
+		WordsFile string `yaml:"words_file"`
+	} `yaml:"world"`
+
+	// Accounts configures account limits and the inactivity policy
+	Accounts struct {
+		// FleetCap is the maximum number of rovers per account, 0 means no limit
+		FleetCap int `yaml:"fleet_cap"`
+
+		// RetireAfter is how long an account can be idle before its fleet is retired, 0 means never
+		RetireAfter time.Duration `yaml:"retire_after"`
+
+		// PurgeAfter is how long an account can be idle before it is deleted, 0 means never
+		PurgeAfter time.Duration `yaml:"purge_after"`
+	} `yaml:"accounts"`
+}
+
+// DefaultConfig returns the config used when nothing is set
+func DefaultConfig() Config {
+	var c Config
+	c.Address = ":9090"
+	c.ShutdownTimeout = defaultShutdownTimeout
+	c.Admin.Address = ":9091"
+	c.Logging.Level = logging.LevelInfo.String()
+	c.Logging.Format = "text"
+	c.Spectate.Rovers = SpectateRoversOptIn
+	c.RateLimits = DefaultRateLimits()
+	c.Storage.Backend = StorageFile
+	c.Tick.Schedule = "1m"
+	c.World.Seed = rove.DefaultSeed
+	c.World.ChunkSize = 32
+	c.World.TicksPerDay = 24
+	return c
+}
+
+// ConfigLoader loads a config from defaults, then a YAML file, then the environment, then flags
+type ConfigLoader struct {
+	flags *flag.FlagSet
+	file  *string
+
+	// parsed holds the flag values, only the flags that were set are used
+	parsed Config
+}
+
+// NewConfigLoader registers flags for the config file and every setting on a flag set
+func NewConfigLoader(fs *flag.FlagSet) *ConfigLoader {
+	l := &ConfigLoader{flags: fs, parsed: DefaultConfig()}
+	l.file = fs.String("config", "", "path to a YAML config file")
+	l.parsed.bindFlags(fs)
+	return l
+}
+
+// Load loads the config once the flag set has been parsed
+func (l *ConfigLoader) Load(getenv func(string) string) (Config, error) {
+	c := DefaultConfig()
+
+	if path := *l.file; len(path) > 0 {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return Config{}, err
+		} else if err := yaml.UnmarshalStrict(b, &c); err != nil {
+			return Config{}, fmt.Errorf("failed to parse config file %s: %s", path, err)
+		}
+	}
+
+	if err := c.applyEnv(getenv); err != nil {
+		return Config{}, err
+	}
+
+	// Copy over any flags that were set
+	bound := flag.NewFlagSet("", flag.ContinueOnError)
+	c.bindFlags(bound)
+	var err error
+	l.flags.Visit(func(f *flag.Flag) {
+		if b := bound.Lookup(f.Name); b != nil && err == nil {
+			err = b.Value.Set(f.Value.String())
+		}
+	})
+	if err != nil {
+		return Config{}, err
+	}
+
+	return c, c.Validate()
+}
+
+// bindFlags binds a flag to every setting
+func (c *Config) bindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.Address, "address", c.Address, "address to serve the game on")
+	fs.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout, "time in-flight requests get to finish when stopping")
+	fs.StringVar(&c.Admin.Address, "admin-address", c.Admin.Address, "address to serve the admin service on")
+	fs.StringVar(&c.Admin.Token, "admin-token", c.Admin.Token, "token for the admin service, disabled when empty")
+	fs.StringVar(&c.Metrics.Address, "metrics-address", c.Metrics.Address, "address to serve Prometheus metrics on, disabled when empty")
+	fs.StringVar(&c.Logging.Level, "log-level", c.Logging.Level, "minimum level to log, debug, info, warn or error")
+	fs.StringVar(&c.Logging.Format, "log-format", c.Logging.Format, "log format, text or json")
+	fs.Var(&c.Logging.Subsystems, "log-subsystems", "comma separated subsystem=level overrides, eg. world=debug,rpc=warn")
+	fs.StringVar(&c.Spectate.Token, "spectator-token", c.Spectate.Token, "token spectators need, anyone can spectate when empty")
+	fs.StringVar(&c.Spectate.Rovers, "spectate-rovers", c.Spectate.Rovers, "rovers shown to spectators, all, opt-in or none")
+	fs.BoolVar(&c.RateLimits.Disabled, "no-rate-limits", c.RateLimits.Disabled, "serve without rate limits")
+	fs.StringVar(&c.Storage.Backend, "storage", c.Storage.Backend, "storage backend, file or memory")
+	fs.StringVar(&c.Storage.Path, "data-path", c.Storage.Path, "directory for the file storage backend")
+	fs.BoolVar(&c.TLS.Disabled, "no-tls", c.TLS.Disabled, "serve without TLS")
+	fs.StringVar(&c.TLS.CertFile, "tls-cert", c.TLS.CertFile, "TLS certificate chain file")
+	fs.StringVar(&c.TLS.KeyFile, "tls-key", c.TLS.KeyFile, "TLS private key file")
+	fs.BoolVar(&c.TLS.SelfSigned, "tls-self-signed", c.TLS.SelfSigned, "generate a self-signed TLS certificate if the files don't exist")
+	fs.StringVar(&c.TLS.ClientCAFile, "tls-client-ca", c.TLS.ClientCAFile, "CA bundle for client certificates, enables mutual TLS")
+	fs.BoolVar(&c.TLS.RequireClientCert, "tls-require-client-cert", c.TLS.RequireClientCert, "reject clients without a valid certificate")
+	fs.StringVar(&c.Tick.Schedule, "tick", c.Tick.Schedule, "tick schedule, manual, a duration or a cron expression")
+	fs.Int64Var(&c.World.Seed, "seed", c.World.Seed, "generation seed for new worlds")
+	fs.IntVar(&c.World.ChunkSize, "chunk-size", c.World.ChunkSize, "atlas chunk size for new worlds")
+	fs.IntVar(&c.World.TicksPerDay, "ticks-per-day", c.World.TicksPerDay, "number of ticks in a day")
+	fs.StringVar(&c.World.RulesFile, "rules-file", c.World.RulesFile, "YAML file of gameplay rules")
+	fs.StringVar(&c.World.RecipesFile, "recipes-file", c.World.RecipesFile, "YAML file of crafting recipes")
+	fs.StringVar(&c.World.WordsFile, "words-file", c.World.WordsFile, "file of words to name rovers with")
+	fs.IntVar(&c.Accounts.FleetCap, "fleet-cap", c.Accounts.FleetCap, "maximum rovers per account, 0 for no limit")
+	fs.DurationVar(&c.Accounts.RetireAfter, "retire-after", c.Accounts.RetireAfter, "idle time before an account's fleet is retired, 0 for never")
+	fs.DurationVar(&c.Accounts.PurgeAfter, "purge-after", c.Accounts.PurgeAfter, "idle time before an account is deleted, 0 for never")
+}
+
+// applyEnv applies the environment variables the server has always supported
+func (c *Config) applyEnv(getenv func(string) string) error {
+	if port := getenv("PORT"); len(port) > 0 {
+		if _, err := strconv.Atoi(port); err != nil {
+			return fmt.Errorf("PORT not valid int: %s", port)
+		}
+		c.Address = ":" + port
+	}
+	if port := getenv("ADMIN_PORT"); len(port) > 0 {
+		if _, err := strconv.Atoi(port); err != nil {
+			return fmt.Errorf("ADMIN_PORT not valid int: %s", port)
+		}
+		c.Admin.Address = ":" + port
+	}
+	if port := getenv("METRICS_PORT"); len(port) > 0 {
+		if _, err := strconv.Atoi(port); err != nil {
+			return fmt.Errorf("METRICS_PORT not valid int: %s", port)
+		}
+		c.Metrics.Address = ":" + port
+	}
+	if level := getenv("LOG_LEVEL"); len(level) > 0 {
+		c.Logging.Level = level
+	}
+	if format := getenv("LOG_FORMAT"); len(format) > 0 {
+		c.Logging.Format = format
+	}
+	if levels := getenv("LOG_SUBSYSTEMS"); len(levels) > 0 {
+		if err := c.Logging.Subsystems.Set(levels); err != nil {
+			return fmt.Errorf("LOG_SUBSYSTEMS not valid: %s", err)
+		}
+	}
+	if token := getenv("ADMIN_TOKEN"); len(token) > 0 {
+		c.Admin.Token = token
+	}
+	if token := getenv("SPECTATOR_TOKEN"); len(token) > 0 {
+		c.Spectate.Token = token
+	}
+	if data := getenv("DATA_PATH"); len(data) > 0 {
+		c.Storage.Path = data
+	}
+	if len(getenv("NO_TLS")) > 0 {
+		c.TLS.Disabled = true
+	}
+	if len(getenv("NO_RATE_LIMITS")) > 0 {
+		c.RateLimits.Disabled = true
+	}
+	if cert := getenv("CERT_NAME"); len(cert) > 0 {
+		c.TLS.CertFile = path.Join("/etc/letsencrypt/live/", cert, "fullchain.pem")
+		c.TLS.KeyFile = path.Join("/etc/letsencrypt/live/", cert, "privkey.pem")
+	}
+	if cert := getenv("TLS_CERT_FILE"); len(cert) > 0 {
+		c.TLS.CertFile = cert
+	}
+	if key := getenv("TLS_KEY_FILE"); len(key) > 0 {
+		c.TLS.KeyFile = key
+	}
+	if len(getenv("TLS_SELF_SIGNED")) > 0 {
+		c.TLS.SelfSigned = true
+	}
+	if ca := getenv("TLS_CLIENT_CA_FILE"); len(ca) > 0 {
+		c.TLS.ClientCAFile = ca
+	}
+	if words := getenv("WORDS_FILE"); len(words) > 0 {
+		c.World.WordsFile = words
+	}
+	if rules := getenv("RULES_FILE"); len(rules) > 0 {
+		c.World.RulesFile = rules
+	}
+	if recipes := getenv("RECIPES_FILE"); len(recipes) > 0 {
+		c.World.RecipesFile = recipes
+	}
+
+	// The schedule takes priority over the older tick rate in minutes
+	if tick := getenv("TICK_RATE"); len(tick) > 0 {
+		minutes, err := strconv.Atoi(tick)
+		if err != nil {
+			return fmt.Errorf("TICK_RATE not set to valid int: %s", err)
+		} else if minutes == 0 {
+			c.Tick.Schedule = "manual"
+		} else {
+			c.Tick.Schedule = (time.Duration(minutes) * time.Minute).String()
+		}
+	}
+	if schedule := getenv("TICK_SCHEDULE"); len(schedule) > 0 {
+		c.Tick.Schedule = schedule
+	}
+
+	if fleetCap := getenv("FLEET_CAP"); len(fleetCap) > 0 {
+		var err error
+		if c.Accounts.FleetCap, err = strconv.Atoi(fleetCap); err != nil {
+			return fmt.Errorf("FLEET_CAP not set to valid int: %s", err)
+		}
+	}
+	if retire := getenv("RETIRE_AFTER"); len(retire) > 0 {
+		var err error
+		if c.Accounts.RetireAfter, err = time.ParseDuration(retire); err != nil {
+			return fmt.Errorf("RETIRE_AFTER not set to valid duration: %s", err)
+		}
+	}
+	if purge := getenv("PURGE_AFTER"); len(purge) > 0 {
+		var err error
+		if c.Accounts.PurgeAfter, err = time.ParseDuration(purge); err != nil {
+			return fmt.Errorf("PURGE_AFTER not set to valid duration: %s", err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the config makes sense
+func (c *Config) Validate() error {
+	switch {
+	case c.Storage.Backend != StorageFile && c.Storage.Backend != StorageMemory:
+		return fmt.Errorf("unknown storage backend: %s", c.Storage.Backend)
+	case c.Storage.Backend == StorageFile && len(c.Storage.Path) == 0:
+		return fmt.Errorf("the file storage backend needs a data path")
+	case !c.TLS.Disabled && !c.TLS.SelfSigned && (len(c.TLS.CertFile) == 0 || len(c.TLS.KeyFile) == 0):
+		return fmt.Errorf("TLS needs a certificate and key file, to be self-signed, or to be disabled")
+	case !c.TLS.Disabled && c.TLS.SelfSigned && len(c.Storage.Path) == 0 && (len(c.TLS.CertFile) == 0 || len(c.TLS.KeyFile) == 0):
+		return fmt.Errorf("self-signed TLS needs a certificate and key file, or a storage path to put them in")
+	case c.TLS.RequireClientCert && len(c.TLS.ClientCAFile) == 0:
+		return fmt.Errorf("requiring client certificates needs a client CA file")
+	case c.World.ChunkSize <= 0:
+		return fmt.Errorf("chunk size must be positive: %d", c.World.ChunkSize)
+	case c.World.TicksPerDay < 2:
+		return fmt.Errorf("ticks per day must be at least 2: %d", c.World.TicksPerDay)
+	case c.ShutdownTimeout < 0:
+		return fmt.Errorf("shutdown timeout can't be negative: %s", c.ShutdownTimeout)
+	case c.Accounts.FleetCap < 0:
+		return fmt.Errorf("fleet cap can't be negative: %d", c.Accounts.FleetCap)
+	}
+
+	if _, err := c.loggingConfig(); err != nil {
+		return err
+	} else if err := c.RateLimits.Validate(); err != nil {
+		return err
+	} else if err := validSpectateRovers(c.Spectate.Rovers); err != nil {
+		return err
+	}
+
+	_, err := ParseScheduler(c.Tick.Schedule)
+	return err
+}
+
+// logSubsystems are the subsystems that can have their own log level
+var logSubsystems = []string{"server", "rpc", "admin", "world", "persistence"}
+
+// loggingConfig parses the logging settings
+func (c *Config) loggingConfig() (logging.Config, error) {
+	var config logging.Config
+	var err error
+	if config.Level, err = logging.ParseLevel(c.Logging.Level); err != nil {
+		return config, err
+	} else if config.Format, err = logging.ParseFormat(c.Logging.Format); err != nil {
+		return config, err
+	}
+
+	config.Subsystems = make(map[string]logging.Level)
+	for name, level := range c.Logging.Subsystems {
+		known := false
+		for _, s := range logSubsystems {
+			known = known || s == name
+		}
+		if !known {
+			return config, fmt.Errorf("unknown log subsystem %q, expected one of %s", name, strings.Join(logSubsystems, ", "))
+		}
+
+		if config.Subsystems[name], err = logging.ParseLevel(level); err != nil {
+			return config, fmt.Errorf("log subsystem %s: %s", name, err)
+		}
+	}
+	return config, nil
+}
+
+// Logger creates the root logger for the config writing to w
+func (c *Config) Logger(w io.Writer) (*logging.Logger, error) {
+	config, err := c.loggingConfig()
+	if err != nil {
+		return nil, err
+	}
+	return logging.New(w, config), nil
+}
+
+// subsystemLevels maps subsystems to log level names
+// As a flag it's set with comma separated subsystem=level pairs, which are merged over any already set
+type subsystemLevels map[string]string
+
+// String returns the levels as sorted subsystem=level pairs
+func (l *subsystemLevels) String() string {
+	var pairs []string
+	for name, level := range *l {
+		pairs = append(pairs, name+"="+level)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set merges comma separated subsystem=level pairs into the levels
+func (l *subsystemLevels) Set(value string) error {
+	if *l == nil {
+		*l = make(subsystemLevels)
+	}
+	for _, pair := range strings.Split(value, ",") {
+		if len(strings.TrimSpace(pair)) == 0 {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("expected subsystem=level, got %q", pair)
+		}
+		(*l)[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return nil
+}
+
+// Options returns the server options for the config
+func (c *Config) Options() ([]ServerOption, error) {
+	scheduler, err := ParseScheduler(c.Tick.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []ServerOption{
+		OptionAddress(c.Address),
+		OptionShutdownTimeout(c.ShutdownTimeout),
+		OptionScheduler(scheduler),
+		OptionWorldGen(c.World.ChunkSize, c.World.Seed),
+		OptionTicksPerDay(c.World.TicksPerDay),
+		OptionFleetCap(c.Accounts.FleetCap),
+		OptionRetireAfter(c.Accounts.RetireAfter),
+		OptionPurgeAfter(c.Accounts.PurgeAfter),
+		OptionAdmin(c.Admin.Address, c.Admin.Token),
+		OptionMetrics(c.Metrics.Address),
+		OptionSpectate(c.Spectate.Token, c.Spectate.Rovers),
+	}
+	if len(c.World.RulesFile) > 0 {
+		rules, err := rove.LoadRules(c.World.RulesFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, OptionRules(rules))
+	}
+	if len(c.World.RecipesFile) > 0 {
+		recipes, err := rove.LoadRecipes(c.World.RecipesFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, OptionRecipes(recipes))
+	}
+	if !c.RateLimits.Disabled {
+		opts = append(opts, OptionRateLimits(c.RateLimits))
+	}
+	if c.Storage.Backend == StorageFile {
+		opts = append(opts, OptionPersistentData())
+	}
+	if !c.TLS.Disabled {
+		certFile, keyFile := c.TLS.CertFile, c.TLS.KeyFile
+		if c.TLS.SelfSigned {
+			if len(certFile) == 0 {
+				certFile = path.Join(c.Storage.Path, "self-signed-cert.pem")
+			}
+			if len(keyFile) == 0 {
+				keyFile = path.Join(c.Storage.Path, "self-signed-key.pem")
+			}
+			opts = append(opts, OptionSelfSignedTLS())
+		}
+		opts = append(opts, OptionTLS(certFile, keyFile))
+
+		if len(c.TLS.ClientCAFile) > 0 {
+			opts = append(opts, OptionClientCA(c.TLS.ClientCAFile, c.TLS.RequireClientCert))
+		}
+	}
+	return opts, nil
+}
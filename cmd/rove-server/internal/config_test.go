@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mdiluz/rove/pkg/rove"
+	"github.com/stretchr/testify/assert"
+)
+
+// env returns a getenv function for a fixed environment
+func env(vars map[string]string) func(string) string {
+	return func(key string) string {
+		return vars[key]
+	}
+}
+
+func TestConfigLoader_Precedence(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-config-")
+	assert.NoError(t, err)
+	rules := path.Join(tmp, "rules.yaml")
+	assert.NoError(t, ioutil.WriteFile(rules, []byte("salvage_parts: 1\n"), 0644))
+	recipes := path.Join(tmp, "recipes.yaml")
+	assert.NoError(t, ioutil.WriteFile(recipes, []byte("- {name: plate, ingredients: {RockSmall: 1}, ticks: 1, product: HullPlate}\n"), 0644))
+	file := path.Join(tmp, "config.yaml")
+	assert.NoError(t, ioutil.WriteFile(file, []byte(`
+address: ":1000"
+storage:
+  path: /from/file
+tls:
+  disabled: true
+tick:
+  schedule: 30s
+world:
+  seed: 7
+  chunk_size: 16
+  rules_file: `+rules+`
+accounts:
+  retire_after: 1h
+`), 0644))
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	l := NewConfigLoader(fs)
+	assert.NoError(t, fs.Parse([]string{"-config", file, "-seed", "9", "-tick", "manual"}))
+
+	c, err := l.Load(env(map[string]string{
+		"DATA_PATH":     "/from/env",
+		"TICK_SCHEDULE": "10s",
+		"FLEET_CAP":     "3",
+		"RECIPES_FILE":  recipes,
+	}))
+	assert.NoError(t, err)
+
+	// The file overrides the defaults
+	assert.Equal(t, ":1000", c.Address)
+	assert.Equal(t, ":9091", c.Admin.Address)
+	assert.Equal(t, 16, c.World.ChunkSize)
+	assert.Equal(t, 24, c.World.TicksPerDay)
+	assert.Equal(t, time.Hour, c.Accounts.RetireAfter)
+	assert.True(t, c.TLS.Disabled)
+
+	// The environment overrides the file
+	assert.Equal(t, "/from/env", c.Storage.Path)
+	assert.Equal(t, 3, c.Accounts.FleetCap)
+
+	// Flags override everything
+	assert.Equal(t, int64(9), c.World.Seed)
+	assert.Equal(t, "manual", c.Tick.Schedule)
+
+	opts, err := c.Options()
+	assert.NoError(t, err)
+	s := NewServer(opts...)
+	assert.Equal(t, ":1000", s.address)
+	assert.Equal(t, PersistentData, s.persistence)
+	assert.Equal(t, 3, s.world.FleetCap)
+	assert.Empty(t, s.certFile)
+	assert.Equal(t, 1, s.world.Rules.SalvageParts)
+	assert.Len(t, s.world.Recipes, 1)
+	assert.NotNil(t, s.limiter)
+	assert.Equal(t, rove.DefaultRules().BroadcastLength, s.world.Rules.BroadcastLength)
+
+	// Unknown settings in the file are rejected
+	assert.NoError(t, ioutil.WriteFile(file, []byte("adress: \":1000\"\n"), 0644))
+	_, err = l.Load(env(nil))
+	assert.Error(t, err)
+}
+
+func TestConfig_Env(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	l := NewConfigLoader(fs)
+	assert.NoError(t, fs.Parse(nil))
+
+	c, err := l.Load(env(map[string]string{
+		"PORT":         "8000",
+		"ADMIN_PORT":   "8001",
+		"METRICS_PORT": "8002",
+		"DATA_PATH":    "/data",
+		"CERT_NAME":    "example.com",
+		"TICK_RATE":    "5",
+		"RETIRE_AFTER": "2h",
+
+		"SPECTATOR_TOKEN": "watch",
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, ":8000", c.Address)
+	assert.Equal(t, ":8001", c.Admin.Address)
+	assert.Equal(t, ":8002", c.Metrics.Address)
+	assert.Equal(t, "/etc/letsencrypt/live/example.com/fullchain.pem", c.TLS.CertFile)
+	assert.Equal(t, "/etc/letsencrypt/live/example.com/privkey.pem", c.TLS.KeyFile)
+	assert.Equal(t, "5m0s", c.Tick.Schedule)
+	assert.Equal(t, 2*time.Hour, c.Accounts.RetireAfter)
+	assert.Equal(t, "watch", c.Spectate.Token)
+	assert.Equal(t, SpectateRoversOptIn, c.Spectate.Rovers)
+
+	_, err = l.Load(env(map[string]string{"PORT": "lots"}))
+	assert.Error(t, err)
+	_, err = l.Load(env(map[string]string{"DATA_PATH": "/data", "NO_TLS": "1", "TICK_RATE": "soon"}))
+	assert.Error(t, err)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := DefaultConfig()
+	valid.Storage.Backend = StorageMemory
+	valid.TLS.Disabled = true
+	assert.NoError(t, valid.Validate())
+
+	for name, change := range map[string]func(c *Config){
+		"backend":    func(c *Config) { c.Storage.Backend = "tape" },
+		"data path":  func(c *Config) { c.Storage.Backend = StorageFile },
+		"tls":        func(c *Config) { c.TLS.Disabled = false },
+		"chunk size": func(c *Config) { c.World.ChunkSize = 0 },
+		"day":        func(c *Config) { c.World.TicksPerDay = 1 },
+		"fleet cap":  func(c *Config) { c.Accounts.FleetCap = -1 },
+		"schedule":   func(c *Config) { c.Tick.Schedule = "whenever" },
+		"self-signed": func(c *Config) {
+			c.TLS.Disabled = false
+			c.TLS.SelfSigned = true
+		},
+		"client cert": func(c *Config) { c.TLS.RequireClientCert = true },
+		"shutdown":    func(c *Config) { c.ShutdownTimeout = -time.Second },
+		"log level":   func(c *Config) { c.Logging.Level = "loud" },
+		"rate limit":  func(c *Config) { c.RateLimits.PerIP.Rate = -1 },
+		"spectate":    func(c *Config) { c.Spectate.Rovers = "some" },
+		"log format":  func(c *Config) { c.Logging.Format = "xml" },
+		"subsystem":   func(c *Config) { c.Logging.Subsystems = subsystemLevels{"ticks": "debug"} },
+		"subsystem level": func(c *Config) {
+			c.Logging.Subsystems = subsystemLevels{"world": "loud"}
+		},
+	} {
+		c := valid
+		change(&c)
+		assert.Error(t, c.Validate(), name)
+	}
+}
+
+func TestConfig_SelfSigned(t *testing.T) {
+	c := DefaultConfig()
+	c.Storage.Path = "/data"
+	c.TLS.SelfSigned = true
+	c.TLS.ClientCAFile = "/data/ca.pem"
+	assert.NoError(t, c.Validate())
+
+	// The self-signed files default to the storage path
+	opts, err := c.Options()
+	assert.NoError(t, err)
+	s := NewServer(opts...)
+	assert.True(t, s.selfSigned)
+	assert.Equal(t, "/data/self-signed-cert.pem", s.certFile)
+	assert.Equal(t, "/data/self-signed-key.pem", s.keyFile)
+	assert.Equal(t, "/data/ca.pem", s.clientCAFile)
+	assert.False(t, s.requireClientCert)
+}
+
+func TestConfig_Logging(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-config-")
+	assert.NoError(t, err)
+	file := path.Join(tmp, "config.yaml")
+	assert.NoError(t, ioutil.WriteFile(file, []byte(`
+storage:
+  backend: memory
+tls:
+  disabled: true
+logging:
+  level: warn
+  subsystems:
+    world: debug
+    rpc: debug
+`), 0644))
+
+	// Subsystem levels from the flags are merged over the file's
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	l := NewConfigLoader(fs)
+	assert.NoError(t, fs.Parse([]string{"-config", file, "-log-subsystems", "rpc=error"}))
+	c, err := l.Load(env(map[string]string{"LOG_FORMAT": "json"}))
+	assert.NoError(t, err)
+	assert.Equal(t, subsystemLevels{"world": "debug", "rpc": "error"}, c.Logging.Subsystems)
+
+	var b bytes.Buffer
+	logger, err := c.Logger(&b)
+	assert.NoError(t, err)
+	logger.Named("world").Debug("world debug")
+	logger.Named("rpc").Warn("rpc warn")
+	logger.Named("server").Info("server info")
+	logger.Named("server").Warn("server warn")
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"msg":"world debug"`)
+	assert.Contains(t, lines[1], `"msg":"server warn"`)
+}
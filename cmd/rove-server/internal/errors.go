@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/mdiluz/rove/pkg/accounts"
+	"github.com/mdiluz/rove/pkg/rove"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errShuttingDown is returned for requests the server won't take on while it's stopping
+var errShuttingDown = errors.New("server is shutting down")
+
+// errorInterceptor converts any errors returned by handlers into gRPC status errors
+func errorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, errorStatus(err)
+}
+
+// errorStatus maps an error to a gRPC status error with a matching code
+func errorStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// Errors that already carry a status are left alone
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var cmdErr *rove.CommandError
+	var limitErr *rateLimitError
+	switch {
+	case errors.As(err, &cmdErr):
+		// Describe exactly which command field was invalid
+		st := status.New(codes.InvalidArgument, err.Error())
+		detailed, derr := st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{
+					Field:       fmt.Sprintf("commands[%d].%s", cmdErr.Index, cmdErr.Field),
+					Description: cmdErr.Description,
+				},
+			},
+		})
+		if derr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+
+	case errors.As(err, &limitErr):
+		// Tell the client when it can try again
+		st := status.New(codes.ResourceExhausted, err.Error())
+		detailed, derr := st.WithDetails(&errdetails.RetryInfo{
+			RetryDelay: ptypes.DurationProto(limitErr.Delay),
+		})
+		if derr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+
+	case errors.Is(err, rove.ErrRoverNotFound),
+		errors.Is(err, accounts.ErrAccountNotFound),
+		errors.Is(err, accounts.ErrTeamNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, rove.ErrInvalidArgument), errors.Is(err, accounts.ErrInvalidName):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, accounts.ErrAccountExists), errors.Is(err, accounts.ErrTeamExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+
+	case errors.Is(err, accounts.ErrAlreadyInTeam), errors.Is(err, accounts.ErrNotInTeam), errors.Is(err, rove.ErrFleetFull), errors.Is(err, rove.ErrNoSpace):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case errors.Is(err, errShuttingDown):
+		return status.Error(codes.Unavailable, err.Error())
+
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
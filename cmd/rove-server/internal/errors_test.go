@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mdiluz/rove/pkg/accounts"
+	"github.com/mdiluz/rove/pkg/rove"
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorStatus(t *testing.T) {
+	assert.NoError(t, errorStatus(nil))
+
+	assert.Equal(t, codes.NotFound, status.Code(errorStatus(fmt.Errorf("error getting rover: %w", rove.ErrRoverNotFound))))
+	assert.Equal(t, codes.NotFound, status.Code(errorStatus(fmt.Errorf("%w: test", accounts.ErrAccountNotFound))))
+	assert.Equal(t, codes.InvalidArgument, status.Code(errorStatus(accounts.ValidateName(""))))
+	assert.Equal(t, codes.AlreadyExists, status.Code(errorStatus(fmt.Errorf("%w: test", accounts.ErrAccountExists))))
+	assert.Equal(t, codes.Unavailable, status.Code(errorStatus(errShuttingDown)))
+	assert.Equal(t, codes.Internal, status.Code(errorStatus(fmt.Errorf("something broke"))))
+
+	// Existing statuses are kept
+	assert.Equal(t, codes.Unauthenticated, status.Code(errorStatus(status.Error(codes.Unauthenticated, "no"))))
+
+	// Invalid commands carry the field violation
+	w := rove.NewWorld(8)
+	err := w.Enqueue("", &roveapi.Command{Command: roveapi.CommandType_wait}, &roveapi.Command{Command: roveapi.CommandType_turn})
+	st := status.Convert(errorStatus(err))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Len(t, st.Details(), 1)
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	assert.True(t, ok)
+	if ok {
+		assert.Equal(t, "commands[1].bearing", br.FieldViolations[0].Field)
+	}
+}
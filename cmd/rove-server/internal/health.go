@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// healthReadiness is the health service that reports whether the world is loaded and ticking
+	healthReadiness = "readiness"
+
+	// healthLiveness is the health service that reports whether the world is still being saved
+	healthLiveness = "liveness"
+
+	// healthRove is the health service for the game API, serving when both ready and live
+	healthRove = "roveapi.Rove"
+)
+
+// serverHealth tracks what the server's health depends on and reports it through the standard gRPC health service
+// The overall "" service matches the game API
+type serverHealth struct {
+	server *health.Server
+
+	mutex   sync.Mutex
+	loaded  bool
+	ticking bool
+	saveErr error
+}
+
+// newServerHealth creates the health for a server that hasn't loaded its world yet
+func newServerHealth() *serverHealth {
+	h := &serverHealth{server: health.NewServer()}
+	h.update()
+	return h
+}
+
+// setLoaded records whether the world was loaded
+func (h *serverHealth) setLoaded(loaded bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.loaded = loaded
+	h.update()
+}
+
+// setTicking records whether the tick schedule is running
+func (h *serverHealth) setTicking(ticking bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.ticking = ticking
+	h.update()
+}
+
+// setSaveError records the result of the last save, nil clears any earlier failure
+func (h *serverHealth) setSaveError(err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.saveErr = err
+	h.update()
+}
+
+// ready returns whether the world is loaded and ticking
+func (h *serverHealth) ready() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.loaded && h.ticking
+}
+
+// update sets the status of every health service, the caller must hold the mutex
+func (h *serverHealth) update() {
+	ready := h.loaded && h.ticking
+	live := h.saveErr == nil
+
+	h.server.SetServingStatus(healthReadiness, servingStatus(ready))
+	h.server.SetServingStatus(healthLiveness, servingStatus(live))
+	h.server.SetServingStatus(healthRove, servingStatus(ready && live))
+	h.server.SetServingStatus("", servingStatus(ready && live))
+}
+
+// servingStatus converts a health check result to its status
+func servingStatus(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mdiluz/rove/pkg/persistence"
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkHealth returns the status of a health service
+func checkHealth(t *testing.T, client healthpb.HealthClient, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	assert.NoError(t, err)
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN
+	}
+	return resp.Status
+}
+
+func TestServerHealth(t *testing.T) {
+	h := newServerHealth()
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := h.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		assert.NoError(t, err)
+		return resp.Status
+	}
+
+	// Nothing is ready until the world is loaded and ticking
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(healthReadiness))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(healthLiveness))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	h.setLoaded(true)
+	assert.False(t, h.ready())
+	h.setTicking(true)
+	assert.True(t, h.ready())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(healthRove))
+
+	// Failing saves leave the server ready but not live
+	h.setSaveError(errors.New("disk full"))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(healthReadiness))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(healthLiveness))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+	h.setSaveError(nil)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status(""))
+}
+
+func TestServer_Health(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-health-")
+	assert.NoError(t, err)
+	assert.NoError(t, persistence.SetPath(tmp))
+	defer persistence.SetPath(os.TempDir())
+
+	s := NewServer(OptionPersistentData(), OptionAddress("localhost:0"))
+	assert.NoError(t, s.Initialise(true))
+	go s.Run()
+	assert.Eventually(t, s.health.ready, time.Second, time.Millisecond)
+
+	status, err := s.ServerStatus(context.Background(), &roveapi.ServerStatusRequest{})
+	assert.NoError(t, err)
+	assert.True(t, status.Ready)
+
+	// Health checks need no credentials
+	conn, err := grpc.Dial(s.netListener.Addr().String(), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkHealth(t, client, ""))
+
+	// A failed save degrades liveness without stopping the server, and the next good save restores it
+	assert.NoError(t, os.RemoveAll(tmp))
+	s.scheduledTick()
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkHealth(t, client, healthLiveness))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkHealth(t, client, healthReadiness))
+	assert.NoError(t, os.Mkdir(tmp, 0755))
+	s.scheduledTick()
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkHealth(t, client, healthLiveness))
+
+	// Stopping the schedule means the server is no longer ready
+	assert.NoError(t, s.StopAndClose())
+	assert.False(t, s.health.ready())
+}
@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mdiluz/rove/pkg/accounts"
+	"github.com/mdiluz/rove/pkg/rove"
+)
+
+// activeKey is the account data key for the time the account was last active
+const activeKey = "active"
+
+// recordActivity marks an account as active now
+func (s *Server) recordActivity(account string) error {
+	return s.world.Accountant.AssignData(account, activeKey, time.Now().Format(time.RFC3339))
+}
+
+// retireFleet leaves all the rovers for an account dormant so others can salvage, transfer into or claim them
+func (s *Server) retireFleet(account string) error {
+	for _, rover := range s.world.Fleet(account) {
+		// Clear out any queued commands
+		if err := s.world.Enqueue(rover); err != nil {
+			return err
+		}
+
+		if err := s.world.DestroyRover(rover); err != nil && !errors.Is(err, rove.ErrRoverNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteAccount retires the fleet for an account and removes the account
+func (s *Server) deleteAccount(account string) error {
+	if err := s.retireFleet(account); err != nil {
+		return err
+	}
+
+	// Leave any team and forget what the account explored
+	if err := s.world.Teams.LeaveTeam(account); err != nil && !errors.Is(err, accounts.ErrNotInTeam) {
+		return err
+	}
+	s.world.ForgetExplored(account)
+
+	s.sessions.end(account)
+	return s.world.Accountant.DeleteAccount(account)
+}
+
+// applyInactivityPolicy retires the fleets of idle accounts, and purges accounts idle for long enough
+func (s *Server) applyInactivityPolicy(now time.Time) error {
+	if s.retireAfter == 0 && s.purgeAfter == 0 {
+		return nil
+	}
+
+	for _, account := range s.world.Accountant.ListAccounts() {
+		active, err := s.world.Accountant.GetValue(account, activeKey)
+		if err != nil {
+			return err
+		}
+
+		last, err := time.Parse(time.RFC3339, active)
+		if err != nil {
+			// Accounts with no recorded activity start counting from now
+			if err := s.world.Accountant.AssignData(account, activeKey, now.Format(time.RFC3339)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		idle := now.Sub(last)
+		switch {
+		case s.purgeAfter > 0 && idle > s.purgeAfter:
+			s.log.Info("Purging inactive account", "account", account, "inactive", idle)
+			if err := s.deleteAccount(account); err != nil {
+				return err
+			}
+
+		case s.retireAfter > 0 && idle > s.retireAfter:
+			if fleet := s.world.Fleet(account); len(fleet) > 0 {
+				s.log.Info("Retiring rovers for inactive account", "account", account, "rovers", len(fleet), "inactive", idle)
+				if err := s.retireFleet(account); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
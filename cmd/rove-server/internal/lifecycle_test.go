@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_DeleteAccount(t *testing.T) {
+	s := NewServer()
+	_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+	fleet := s.world.Fleet("test")
+	assert.Len(t, fleet, 1)
+
+	ctx := context.WithValue(context.Background(), accountKey{}, "test")
+	_, err = s.DeleteAccount(ctx, &roveapi.DeleteAccountRequest{})
+	assert.NoError(t, err)
+
+	// The fleet is left dormant and the name is free again
+	_, err = s.world.GetRover(fleet[0])
+	assert.Error(t, err)
+	assert.Empty(t, s.world.Accountant.ListAccounts())
+
+	_, err = s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+}
+
+func TestServer_InactivityPolicy(t *testing.T) {
+	s := NewServer(OptionRetireAfter(time.Hour), OptionPurgeAfter(24*time.Hour))
+	_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+	fleet := s.world.Fleet("test")
+	assert.Len(t, fleet, 1)
+
+	// Accounts with no activity start counting from the first check
+	now := time.Now()
+	assert.NoError(t, s.applyInactivityPolicy(now))
+	_, err = s.world.GetRover(fleet[0])
+	assert.NoError(t, err)
+
+	// After a while idle the rover is retired
+	assert.NoError(t, s.applyInactivityPolicy(now.Add(2*time.Hour)))
+	_, err = s.world.GetRover(fleet[0])
+	assert.Error(t, err)
+	assert.Empty(t, s.world.Fleet("test"))
+
+	// And eventually the account is purged
+	assert.NoError(t, s.applyInactivityPolicy(now.Add(48*time.Hour)))
+	assert.Empty(t, s.world.Accountant.ListAccounts())
+}
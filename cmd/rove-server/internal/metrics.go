@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/mdiluz/rove/pkg/rove"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// metricsPath is the HTTP path metrics are served on
+const metricsPath = "/metrics"
+
+// metrics holds the server's metrics in its own registry
+type metrics struct {
+	registry *prometheus.Registry
+
+	tickDuration prometheus.Histogram
+	saveDuration prometheus.Histogram
+	saveSize     prometheus.Gauge
+	rpcRequests  *prometheus.CounterVec
+	rpcDuration  *prometheus.HistogramVec
+}
+
+// newMetrics creates the metrics for a server, reading the world stats whenever they're gathered
+func newMetrics(stats func() rove.WorldStats) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		tickDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "rove_tick_duration_seconds",
+			Help: "Time taken to tick the world.",
+		}),
+		saveDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "rove_save_duration_seconds",
+			Help: "Time taken to save the world.",
+		}),
+		saveSize: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "rove_save_size_bytes",
+			Help: "Size of the last saved world.",
+		}),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "rove_rpc_requests_total",
+			Help: "RPCs handled, by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "rove_rpc_duration_seconds",
+			Help: "Time taken to handle RPCs, by method and status code.",
+		}, []string{"method", "code"}),
+	}
+
+	m.registry.MustRegister(
+		m.tickDuration,
+		m.saveDuration,
+		m.saveSize,
+		m.rpcRequests,
+		m.rpcDuration,
+		&worldCollector{stats: stats},
+	)
+	return m
+}
+
+var (
+	roversDesc        = prometheus.NewDesc("rove_rovers", "Rovers in the world, by state.", []string{"state"}, nil)
+	atlasChunksDesc   = prometheus.NewDesc("rove_atlas_chunks", "Generated atlas chunks.", nil, nil)
+	atlasBytesDesc    = prometheus.NewDesc("rove_atlas_bytes", "Estimated memory used by the atlas.", nil, nil)
+	queuedDesc        = prometheus.NewDesc("rove_queued_commands", "Commands queued across all rovers.", nil, nil)
+	maxQueueDepthDesc = prometheus.NewDesc("rove_max_queue_depth", "Most commands queued for a single rover.", nil, nil)
+)
+
+// worldCollector collects gauges from the world stats, read once per gather
+type worldCollector struct {
+	stats func() rove.WorldStats
+}
+
+// Describe sends the descriptions of all the world gauges
+func (c *worldCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- roversDesc
+	ch <- atlasChunksDesc
+	ch <- atlasBytesDesc
+	ch <- queuedDesc
+	ch <- maxQueueDepthDesc
+}
+
+// Collect sends the current world gauges
+func (c *worldCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.stats()
+	ch <- prometheus.MustNewConstMetric(roversDesc, prometheus.GaugeValue, float64(s.LiveRovers), "live")
+	ch <- prometheus.MustNewConstMetric(roversDesc, prometheus.GaugeValue, float64(s.DormantRovers), "dormant")
+	ch <- prometheus.MustNewConstMetric(atlasChunksDesc, prometheus.GaugeValue, float64(s.Atlas.Chunks))
+	ch <- prometheus.MustNewConstMetric(atlasBytesDesc, prometheus.GaugeValue, float64(s.Atlas.Bytes))
+	ch <- prometheus.MustNewConstMetric(queuedDesc, prometheus.GaugeValue, float64(s.QueuedCommands))
+	ch <- prometheus.MustNewConstMetric(maxQueueDepthDesc, prometheus.GaugeValue, float64(s.MaxQueueDepth))
+}
+
+// handler serves the metrics over HTTP
+func (m *metrics) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	return mux
+}
+
+// interceptor records the count and latency of every RPC
+func (m *metrics) interceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err).String()
+	m.rpcRequests.WithLabelValues(info.FullMethod, code).Inc()
+	m.rpcDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+	return resp, err
+}
@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetrics_Interceptor(t *testing.T) {
+	s := NewServer()
+	info := &grpc.UnaryServerInfo{FullMethod: "/roveapi.Rove/Status"}
+
+	_, err := s.metrics.interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	assert.NoError(t, err)
+	_, err = s.metrics.interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "no")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(s.metrics.rpcRequests.WithLabelValues(info.FullMethod, "OK")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(s.metrics.rpcRequests.WithLabelValues(info.FullMethod, "NotFound")))
+}
+
+func TestMetrics_World(t *testing.T) {
+	s := NewServer()
+	_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+	rover := s.world.Fleet("test")[0]
+	assert.NoError(t, s.world.Enqueue(rover, &roveapi.Command{Command: roveapi.CommandType_wait, Repeat: 5}, &roveapi.Command{Command: roveapi.CommandType_toggle}))
+
+	s.tick()
+
+	// Gather over HTTP as a scraper would
+	rec := httptest.NewRecorder()
+	s.metrics.handler().ServeHTTP(rec, httptest.NewRequest("GET", metricsPath, nil))
+	body, err := ioutil.ReadAll(rec.Body)
+	assert.NoError(t, err)
+
+	assert.Contains(t, string(body), "rove_tick_duration_seconds_count 1")
+	assert.Contains(t, string(body), `rove_rovers{state="live"} 1`)
+	assert.Contains(t, string(body), `rove_rovers{state="dormant"} 0`)
+	assert.Contains(t, string(body), "rove_queued_commands 2")
+	assert.Contains(t, string(body), "rove_max_queue_depth 2")
+	assert.Contains(t, string(body), "rove_atlas_chunks")
+}
@@ -0,0 +1,252 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// rateLimitSweepInterval is how often buckets that have refilled are dropped
+const rateLimitSweepInterval = time.Minute
+
+// RateLimit is a token bucket that refills at Rate requests a second up to Burst requests
+// A zero rate leaves requests unlimited
+type RateLimit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// MethodRateLimits overrides the default limits for an RPC, unset limits keep the defaults
+type MethodRateLimits struct {
+	PerAccount *RateLimit `yaml:"per_account"`
+	PerIP      *RateLimit `yaml:"per_ip"`
+}
+
+// RateLimits configures how often clients can call the game RPCs
+// Every RPC has its own buckets for each account and each client IP
+type RateLimits struct {
+	// Disabled turns off all rate limiting
+	Disabled bool `yaml:"disabled"`
+
+	// PerAccount and PerIP are the default limits for each RPC
+	PerAccount RateLimit `yaml:"per_account"`
+	PerIP      RateLimit `yaml:"per_ip"`
+
+	// Methods overrides the defaults for RPCs by name, such as Register
+	Methods map[string]MethodRateLimits `yaml:"methods"`
+}
+
+// DefaultRateLimits returns limits generous enough for any normal client
+// Register saves the whole world so is limited much harder
+func DefaultRateLimits() RateLimits {
+	return RateLimits{
+		PerAccount: RateLimit{Rate: 10, Burst: 50},
+		PerIP:      RateLimit{Rate: 50, Burst: 200},
+		Methods: map[string]MethodRateLimits{
+			"Register": {PerIP: &RateLimit{Rate: 0.1, Burst: 10}},
+			"Command":  {PerAccount: &RateLimit{Rate: 5, Burst: 30}},
+			"Radar":    {PerAccount: &RateLimit{Rate: 5, Burst: 20}},
+		},
+	}
+}
+
+// Validate checks the limits make sense
+func (r RateLimits) Validate() error {
+	check := func(name string, l *RateLimit) error {
+		switch {
+		case l == nil:
+			return nil
+		case l.Rate < 0:
+			return fmt.Errorf("rate limit %s can't have a negative rate: %g", name, l.Rate)
+		case l.Rate > 0 && l.Burst < 1:
+			return fmt.Errorf("rate limit %s needs a burst of at least 1: %d", name, l.Burst)
+		}
+		return nil
+	}
+
+	if err := check("per_account", &r.PerAccount); err != nil {
+		return err
+	} else if err := check("per_ip", &r.PerIP); err != nil {
+		return err
+	}
+
+	methods := roveapi.File_roveapi_roveapi_proto.Services().ByName("Rove").Methods()
+	for name, m := range r.Methods {
+		if methods.ByName(protoreflect.Name(name)) == nil {
+			return fmt.Errorf("rate limit for unknown method: %s", name)
+		} else if err := check(name+".per_account", m.PerAccount); err != nil {
+			return err
+		} else if err := check(name+".per_ip", m.PerIP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// limits returns the per account and per IP limits for an RPC
+func (r RateLimits) limits(method string) (account RateLimit, ip RateLimit) {
+	account, ip = r.PerAccount, r.PerIP
+	if m, ok := r.Methods[method]; ok {
+		if m.PerAccount != nil {
+			account = *m.PerAccount
+		}
+		if m.PerIP != nil {
+			ip = *m.PerIP
+		}
+	}
+	return
+}
+
+// rateLimitError is returned for requests over a rate limit
+type rateLimitError struct {
+	// Method is the RPC that was limited
+	Method string
+
+	// Delay is how long until the request would be allowed
+	Delay time.Duration
+}
+
+// Error describes the limit and when to retry
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded for %s, retry in %s", e.Method, e.Delay.Round(time.Millisecond))
+}
+
+// bucketKey identifies the bucket for an RPC and an account or IP
+type bucketKey struct {
+	method string
+	kind   string
+	key    string
+}
+
+// bucket is a token bucket along with when it was last used
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+	refill   time.Duration
+}
+
+// rateLimiter holds a token bucket for each RPC and each account or IP calling it
+// A nil rateLimiter allows everything
+type rateLimiter struct {
+	limits RateLimits
+	now    func() time.Time
+
+	mutex     sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+// newRateLimiter creates a rate limiter for a set of limits
+func newRateLimiter(limits RateLimits) *rateLimiter {
+	return &rateLimiter{
+		limits:  limits,
+		now:     time.Now,
+		buckets: make(map[bucketKey]*bucket),
+	}
+}
+
+// take takes a token from a bucket, returning how long until one is available if it's empty
+func (l *rateLimiter) take(k bucketKey, limit RateLimit) time.Duration {
+	if limit.Rate <= 0 {
+		return 0
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[k]
+	if !ok {
+		b = &bucket{
+			limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst),
+			refill:  time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second)),
+		}
+		l.buckets[k] = b
+	}
+	b.lastUsed = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if d := r.DelayFrom(now); d > 0 {
+		// Don't hold on to the token, the request isn't going ahead
+		r.CancelAt(now)
+		return d
+	}
+	return 0
+}
+
+// sweep drops buckets that have been idle long enough to refill, the caller must hold the mutex
+// A dropped bucket is recreated full, so this doesn't change any limits
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for k, b := range l.buckets {
+		if now.Sub(b.lastUsed) > b.refill {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// peerInterceptor limits each client IP, before requests are authenticated
+func (l *rateLimiter) peerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.checkPeer(ctx, path.Base(info.FullMethod)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// checkPeer takes a token for the client IP calling a method, streams call this directly as they skip the interceptors
+func (l *rateLimiter) checkPeer(ctx context.Context, method string) error {
+	if l == nil || l.limits.Disabled {
+		return nil
+	}
+
+	if ip := peerIP(ctx); len(ip) > 0 {
+		_, limit := l.limits.limits(method)
+		if d := l.take(bucketKey{method: method, kind: "ip", key: ip}, limit); d > 0 {
+			return &rateLimitError{Method: method, Delay: d}
+		}
+	}
+	return nil
+}
+
+// accountInterceptor limits each account, once requests are authenticated
+func (l *rateLimiter) accountInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if l == nil || l.limits.Disabled {
+		return handler(ctx, req)
+	}
+
+	method := path.Base(info.FullMethod)
+	if account := accountFromContext(ctx); len(account) > 0 {
+		limit, _ := l.limits.limits(method)
+		if d := l.take(bucketKey{method: method, kind: "account", key: account}, limit); d > 0 {
+			return nil, &rateLimitError{Method: method, Delay: d}
+		}
+	}
+	return handler(ctx, req)
+}
+
+// peerIP returns the IP address of the client, without its port
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// peerContext returns a context for a request from an IP
+func peerContext(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+}
+
+func TestRateLimits_Validate(t *testing.T) {
+	assert.NoError(t, DefaultRateLimits().Validate())
+	assert.NoError(t, RateLimits{}.Validate())
+
+	assert.Error(t, RateLimits{PerIP: RateLimit{Rate: -1}}.Validate())
+	assert.Error(t, RateLimits{PerAccount: RateLimit{Rate: 1}}.Validate())
+	assert.Error(t, RateLimits{Methods: map[string]MethodRateLimits{"Teleport": {}}}.Validate())
+	assert.Error(t, RateLimits{Methods: map[string]MethodRateLimits{"Radar": {PerIP: &RateLimit{Rate: 1}}}}.Validate())
+}
+
+func TestRateLimiter_PerIP(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(RateLimits{
+		PerIP: RateLimit{Rate: 100, Burst: 100},
+		Methods: map[string]MethodRateLimits{
+			"Register": {PerIP: &RateLimit{Rate: 1, Burst: 2}},
+		},
+	})
+	l.now = func() time.Time { return now }
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/roveapi.Rove/Register"}
+	call := func(ip string) error {
+		_, err := l.peerInterceptor(peerContext(ip), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	// The burst is allowed, then the client has to wait for the bucket to refill
+	assert.NoError(t, call("10.0.0.1"))
+	assert.NoError(t, call("10.0.0.1"))
+	err := call("10.0.0.1")
+	assert.Error(t, err)
+
+	// The error tells the client when to retry
+	st := status.Convert(errorStatus(err))
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Len(t, st.Details(), 1)
+	if info, ok := st.Details()[0].(*errdetails.RetryInfo); assert.True(t, ok) {
+		delay, err := ptypes.Duration(info.RetryDelay)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Second, delay)
+	}
+
+	// Other clients have their own buckets
+	assert.NoError(t, call("10.0.0.2"))
+
+	now = now.Add(time.Second)
+	assert.NoError(t, call("10.0.0.1"))
+	assert.Error(t, call("10.0.0.1"))
+
+	// Buckets that have refilled are dropped
+	now = now.Add(2 * rateLimitSweepInterval)
+	assert.NoError(t, call("10.0.0.3"))
+	assert.Len(t, l.buckets, 1)
+}
+
+func TestRateLimiter_PerAccount(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(RateLimits{
+		PerAccount: RateLimit{Rate: 1, Burst: 1},
+		Methods: map[string]MethodRateLimits{
+			"Status": {PerAccount: &RateLimit{}},
+		},
+	})
+	l.now = func() time.Time { return now }
+
+	call := func(account string, method string) error {
+		ctx := context.WithValue(context.Background(), accountKey{}, account)
+		_, err := l.accountInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/roveapi.Rove/" + method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	assert.NoError(t, call("a", "Command"))
+	assert.Error(t, call("a", "Command"))
+
+	// Each account and RPC is limited separately, and a zero rate is unlimited
+	assert.NoError(t, call("b", "Command"))
+	assert.NoError(t, call("a", "Radar"))
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, call("a", "Status"))
+	}
+
+	// Nothing is limited when disabled, or without a limiter
+	l.limits.Disabled = true
+	assert.NoError(t, call("a", "Command"))
+	l = nil
+	assert.NoError(t, call("a", "Command"))
+}
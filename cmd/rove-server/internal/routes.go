@@ -3,7 +3,8 @@ package internal
 import (
 	"context"
 	"fmt"
-	"log"
+	"sort"
+	"time"
 
 	"github.com/mdiluz/rove/pkg/version"
 	"github.com/mdiluz/rove/proto/roveapi"
@@ -12,15 +13,22 @@ import (
 // ServerStatus returns the status of the current server to a gRPC request
 func (s *Server) ServerStatus(context.Context, *roveapi.ServerStatusRequest) (*roveapi.ServerStatusResponse, error) {
 	response := &roveapi.ServerStatusResponse{
-		Ready:       true,
+		Ready:       s.health.ready(),
 		Version:     version.Version,
-		TickRate:    int32(s.minutesPerTick),
 		CurrentTick: int32(s.world.CurrentTicks),
+		Schedule:    s.scheduler.String(),
 	}
 
-	// If there's a schedule, respond with it
-	if len(s.schedule.Entries()) > 0 {
-		response.NextTick = s.schedule.Entries()[0].Next.Format("15:04:05")
+	// Older clients only understand ticks in whole minutes
+	if c, ok := s.scheduler.(*cronScheduler); ok {
+		if i, ok := c.schedule.(intervalSchedule); ok && i.every%time.Minute == 0 {
+			response.TickRate = int32(i.every / time.Minute)
+		}
+	}
+
+	// If there's a tick scheduled, respond with it
+	if next := s.scheduler.Next(); !next.IsZero() {
+		response.NextTick = next.Format(time.RFC3339)
 	}
 
 	return response, nil
@@ -28,13 +36,9 @@ func (s *Server) ServerStatus(context.Context, *roveapi.ServerStatusRequest) (*r
 
 // Register registers a new account for a gRPC request
 func (s *Server) Register(ctx context.Context, req *roveapi.RegisterRequest) (*roveapi.RegisterResponse, error) {
-	log.Printf("Handling register request: %s\n", req.Name)
+	s.rpcLog.Info("Handling register request", "account", req.Name)
 
-	if len(req.Name) == 0 {
-		return nil, fmt.Errorf("empty account name")
-	}
-
-	if acc, err := s.world.Accountant.RegisterAccount(req.Name); err != nil {
+	if acc, secret, err := s.world.Accountant.RegisterAccount(req.Name); err != nil {
 		return nil, err
 
 	} else if _, err := s.SpawnRoverForAccount(req.Name); err != nil {
@@ -47,35 +51,96 @@ func (s *Server) Register(ctx context.Context, req *roveapi.RegisterRequest) (*r
 		return &roveapi.RegisterResponse{
 			Account: &roveapi.Account{
 				Name:   acc.Name,
-				Secret: acc.Data["secret"],
+				Secret: secret,
 			},
 		}, nil
 	}
 }
 
-// Status returns rover information for a gRPC request
-func (s *Server) Status(ctx context.Context, req *roveapi.StatusRequest) (response *roveapi.StatusResponse, err error) {
-	log.Printf("Handling status request: %s\n", req.Account.Name)
+// Login starts a session for the authenticated account for a gRPC request
+func (s *Server) Login(ctx context.Context, req *roveapi.LoginRequest) (*roveapi.LoginResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling login request", "account", account)
+
+	token, expires := s.sessions.start(account)
+	return &roveapi.LoginResponse{
+		Token:   token,
+		Expires: expires.Format(time.RFC3339),
+	}, nil
+}
 
-	if valid, err := s.world.Accountant.VerifySecret(req.Account.Name, req.Account.Secret); err != nil {
+// RotateSecret replaces the secret for an account for a gRPC request
+func (s *Server) RotateSecret(ctx context.Context, req *roveapi.RotateSecretRequest) (*roveapi.RotateSecretResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling rotate secret request", "account", account)
+
+	if secret, err := s.world.Accountant.RotateSecret(account); err != nil {
 		return nil, err
 
-	} else if !valid {
-		return nil, fmt.Errorf("Secret incorrect for account %s", req.Account.Name)
+	} else if err := s.SaveWorld(); err != nil {
+		return nil, fmt.Errorf("internal server error when saving world: %s", err)
+
+	} else {
+		// Any sessions started with the old secret are no longer valid
+		s.sessions.end(account)
+
+		return &roveapi.RotateSecretResponse{
+			Account: &roveapi.Account{
+				Name:   account,
+				Secret: secret,
+			},
+		}, nil
+	}
+}
+
+// DeleteAccount deletes the authenticated account for a gRPC request
+func (s *Server) DeleteAccount(ctx context.Context, req *roveapi.DeleteAccountRequest) (*roveapi.DeleteAccountResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling delete account request", "account", account)
 
-	} else if resp, err := s.world.Accountant.GetValue(req.Account.Name, "rover"); err != nil {
+	if err := s.deleteAccount(account); err != nil {
 		return nil, err
 
-	} else if rover, err := s.world.GetRover(resp); err != nil {
-		return nil, fmt.Errorf("error getting rover: %s", err)
+	} else if err := s.SaveWorld(); err != nil {
+		return nil, fmt.Errorf("internal server error when saving world: %s", err)
+	}
+
+	return &roveapi.DeleteAccountResponse{}, nil
+}
+
+// Status returns rover information for a gRPC request
+func (s *Server) Status(ctx context.Context, req *roveapi.StatusRequest) (response *roveapi.StatusResponse, err error) {
+	s.rpcLog.Info("Handling status request", "account", accountFromContext(ctx), "rover", req.Rover)
+
+	if name, err := s.ownedRover(ctx, req.Rover); err != nil {
+		return nil, err
+
+	} else if rover, err := s.world.GetRover(name); err != nil {
+		return nil, fmt.Errorf("error getting rover: %w", err)
 
 	} else {
-		var inv []byte
+		var inv []*roveapi.InventoryItem
 		for _, i := range rover.Inventory {
-			inv = append(inv, byte(i.Type))
+			inv = append(inv, &roveapi.InventoryItem{
+				Object: i.Type,
+				Count:  int32(i.Count),
+				Weight: int32(i.Weight()),
+				Data:   i.Data,
+			})
+		}
+
+		var loadout []*roveapi.InstalledComponent
+		for slot, o := range rover.Loadout {
+			loadout = append(loadout, &roveapi.InstalledComponent{
+				Slot:   slot,
+				Object: o.Type,
+			})
 		}
+		sort.Slice(loadout, func(i, j int) bool {
+			return loadout[i].Slot < loadout[j].Slot
+		})
 
-		queued := s.world.RoverCommands(resp)
+		queued := s.world.RoverCommands(rover.Name)
 		var logs []*roveapi.Log
 		for _, log := range rover.Logs {
 			logs = append(logs, &roveapi.Log{
@@ -99,14 +164,18 @@ func (s *Server) Status(ctx context.Context, req *roveapi.StatusRequest) (respon
 				Capacity:         int32(rover.Capacity),
 				MaximumIntegrity: int32(rover.MaximumIntegrity),
 				MaximumCharge:    int32(rover.MaximumCharge),
+				Loadout:          loadout,
 			},
 			Status: &roveapi.RoverStatus{
-				Bearing:        rover.Bearing,
-				Inventory:      inv,
-				Integrity:      int32(rover.Integrity),
-				Charge:         int32(rover.Charge),
-				QueuedCommands: queued,
-				SailPosition:   rover.SailPosition,
+				Bearing:         rover.Bearing,
+				Inventory:       inv,
+				InventoryWeight: int32(rover.Inventory.Weight()),
+				Integrity:       int32(rover.Integrity),
+				Charge:          int32(rover.Charge),
+				QueuedCommands:  queued,
+				SailPosition:    rover.SailPosition,
+				Crafting:        rover.Crafting,
+				CraftingTicks:   int32(rover.CraftTicks),
 			},
 		}
 	}
@@ -115,31 +184,39 @@ func (s *Server) Status(ctx context.Context, req *roveapi.StatusRequest) (respon
 
 // Radar returns the radar information for a rover
 func (s *Server) Radar(ctx context.Context, req *roveapi.RadarRequest) (*roveapi.RadarResponse, error) {
-	log.Printf("Handling radar request: %s\n", req.Account.Name)
-
-	if valid, err := s.world.Accountant.VerifySecret(req.Account.Name, req.Account.Secret); err != nil {
-		return nil, err
-
-	} else if !valid {
-		return nil, fmt.Errorf("Secret incorrect for account %s", req.Account.Name)
-	}
+	s.rpcLog.Info("Handling radar request", "account", accountFromContext(ctx), "rover", req.Rover)
 
 	response := &roveapi.RadarResponse{}
 
-	resp, err := s.world.Accountant.GetValue(req.Account.Name, "rover")
+	resp, err := s.ownedRover(ctx, req.Rover)
 	if err != nil {
 		return nil, err
 
 	} else if rover, err := s.world.GetRover(resp); err != nil {
-		return nil, fmt.Errorf("error getting rover attributes: %s", err)
+		return nil, fmt.Errorf("error getting rover attributes: %w", err)
 
 	} else if radar, objs, err := s.world.RadarFromRover(resp); err != nil {
-		return nil, fmt.Errorf("error getting radar from rover: %s", err)
+		return nil, fmt.Errorf("error getting radar from rover: %w", err)
 
 	} else {
 		response.Objects = objs
 		response.Tiles = radar
 		response.Range = int32(rover.Range)
+
+		// Teammates are always visible
+		for _, r := range s.world.TeamRovers(rover.Owner) {
+			if r.Name == rover.Name {
+				continue
+			}
+			response.Teammates = append(response.Teammates, &roveapi.TeamRover{
+				Name:    r.Name,
+				Account: r.Owner,
+				Position: &roveapi.Vector{
+					X: int32(r.Pos.X),
+					Y: int32(r.Pos.Y),
+				},
+			})
+		}
 	}
 
 	return response, nil
@@ -147,23 +224,105 @@ func (s *Server) Radar(ctx context.Context, req *roveapi.RadarRequest) (*roveapi
 
 // Command issues commands to the world based on a gRPC request
 func (s *Server) Command(ctx context.Context, req *roveapi.CommandRequest) (*roveapi.CommandResponse, error) {
-	log.Printf("Handling command request: %s and %+v\n", req.Account.Name, req.Commands)
+	s.rpcLog.Info("Handling command request", "account", accountFromContext(ctx), "rover", req.Rover, "commands", req.Commands)
 
-	if valid, err := s.world.Accountant.VerifySecret(req.Account.Name, req.Account.Secret); err != nil {
+	// Commands queued now would never run
+	if s.isDraining() {
+		return nil, errShuttingDown
+	}
+
+	rover, err := s.ownedRover(ctx, req.Rover)
+	if err != nil {
 		return nil, err
+	}
 
-	} else if !valid {
-		return nil, fmt.Errorf("Secret incorrect for account %s", req.Account.Name)
+	if err := s.world.Enqueue(rover, req.Commands...); err != nil {
+		return nil, err
 	}
 
-	resp, err := s.world.Accountant.GetValue(req.Account.Name, "rover")
+	return &roveapi.CommandResponse{}, nil
+}
+
+// Fleet returns the rovers owned by the account for a gRPC request
+func (s *Server) Fleet(ctx context.Context, req *roveapi.FleetRequest) (*roveapi.FleetResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling fleet request", "account", account)
+
+	return &roveapi.FleetResponse{
+		Rovers: s.world.Fleet(account),
+		Cap:    int32(s.world.FleetCap),
+	}, nil
+}
+
+// CreateTeam creates a team for a gRPC request
+func (s *Server) CreateTeam(ctx context.Context, req *roveapi.CreateTeamRequest) (*roveapi.TeamResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling create team request", "account", account, "team", req.Name)
+
+	if err := s.world.Teams.CreateTeam(req.Name, account); err != nil {
+		return nil, err
+	}
+	return s.teamResponse(account)
+}
+
+// JoinTeam joins a team for a gRPC request
+func (s *Server) JoinTeam(ctx context.Context, req *roveapi.JoinTeamRequest) (*roveapi.TeamResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling join team request", "account", account, "team", req.Name)
+
+	if err := s.world.Teams.JoinTeam(req.Name, account); err != nil {
+		return nil, err
+	}
+	return s.teamResponse(account)
+}
+
+// LeaveTeam leaves the current team for a gRPC request
+func (s *Server) LeaveTeam(ctx context.Context, req *roveapi.LeaveTeamRequest) (*roveapi.LeaveTeamResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling leave team request", "account", account)
+
+	if err := s.world.Teams.LeaveTeam(account); err != nil {
+		return nil, err
+	}
+	return &roveapi.LeaveTeamResponse{}, nil
+}
+
+// TeamMessage messages the team for a gRPC request
+func (s *Server) TeamMessage(ctx context.Context, req *roveapi.TeamMessageRequest) (*roveapi.TeamMessageResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling team message request", "account", account)
+
+	if err := s.world.TeamBroadcast(account, req.Message); err != nil {
+		return nil, err
+	}
+	return &roveapi.TeamMessageResponse{}, nil
+}
+
+// Map returns the explored map around a rover for a gRPC request
+func (s *Server) Map(ctx context.Context, req *roveapi.MapRequest) (*roveapi.MapResponse, error) {
+	s.rpcLog.Info("Handling map request", "account", accountFromContext(ctx), "rover", req.Rover, "range", req.Range)
+
+	rover, err := s.ownedRover(ctx, req.Rover)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.world.Enqueue(resp, req.Commands...); err != nil {
+	tiles, objs, err := s.world.MapFromRover(rover, int(req.Range))
+	if err != nil {
 		return nil, err
 	}
 
-	return &roveapi.CommandResponse{}, nil
+	return &roveapi.MapResponse{
+		Range:   req.Range,
+		Tiles:   tiles,
+		Objects: objs,
+	}, nil
+}
+
+// teamResponse describes the team for an account
+func (s *Server) teamResponse(account string) (*roveapi.TeamResponse, error) {
+	return &roveapi.TeamResponse{
+		Name:    s.world.Teams.TeamOf(account),
+		Members: s.world.Teams.Teammates(account),
+	}, nil
 }
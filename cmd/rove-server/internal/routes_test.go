@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_Fleet(t *testing.T) {
+	s := NewServer(OptionFleetCap(2))
+	for _, name := range []string{"one", "two"} {
+		_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: name})
+		assert.NoError(t, err)
+	}
+	ctx := context.WithValue(context.Background(), accountKey{}, "one")
+
+	fleet, err := s.Fleet(ctx, &roveapi.FleetRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, fleet.Rovers, 1)
+	assert.Equal(t, int32(2), fleet.Cap)
+
+	// Requests default to the first rover in the fleet
+	resp, err := s.Status(ctx, &roveapi.StatusRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, fleet.Rovers[0], resp.Spec.Name)
+
+	// Or can name a rover in the fleet
+	resp, err = s.Status(ctx, &roveapi.StatusRequest{Rover: fleet.Rovers[0]})
+	assert.NoError(t, err)
+	assert.Equal(t, fleet.Rovers[0], resp.Spec.Name)
+
+	// But not one owned by another account
+	other := s.world.Fleet("two")
+	assert.Len(t, other, 1)
+	_, err = s.Command(ctx, &roveapi.CommandRequest{Rover: other[0]})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// And unknown rovers aren't found
+	_, err = s.Radar(ctx, &roveapi.RadarRequest{Rover: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(errorStatus(err)))
+}
+
+func TestServer_Teams(t *testing.T) {
+	s := NewServer()
+	for _, name := range []string{"one", "two"} {
+		_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: name})
+		assert.NoError(t, err)
+	}
+	one := context.WithValue(context.Background(), accountKey{}, "one")
+	two := context.WithValue(context.Background(), accountKey{}, "two")
+
+	team, err := s.CreateTeam(one, &roveapi.CreateTeamRequest{Name: "team"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one"}, team.Members)
+
+	_, err = s.JoinTeam(two, &roveapi.JoinTeamRequest{Name: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(errorStatus(err)))
+	team, err = s.JoinTeam(two, &roveapi.JoinTeamRequest{Name: "team"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, team.Members)
+
+	// Teammates show up on the radar regardless of range
+	radar, err := s.Radar(one, &roveapi.RadarRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, radar.Teammates, 1)
+	assert.Equal(t, "two", radar.Teammates[0].Account)
+
+	_, err = s.TeamMessage(one, &roveapi.TeamMessageRequest{Message: "hi"})
+	assert.NoError(t, err)
+
+	// The map needs a valid range
+	s.world.Tick()
+	_, err = s.Map(one, &roveapi.MapRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(errorStatus(err)))
+	m, err := s.Map(one, &roveapi.MapRequest{Range: 2})
+	assert.NoError(t, err)
+	assert.Len(t, m.Tiles, 25)
+
+	_, err = s.LeaveTeam(two, &roveapi.LeaveTeamRequest{})
+	assert.NoError(t, err)
+	_, err = s.LeaveTeam(two, &roveapi.LeaveTeamRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(errorStatus(err)))
+	_, err = s.TeamMessage(two, &roveapi.TeamMessageRequest{Message: "hi"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(errorStatus(err)))
+}
+
+func TestServer_ServerStatus(t *testing.T) {
+	s := NewServer()
+	resp, err := s.ServerStatus(context.Background(), &roveapi.ServerStatusRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "manual", resp.Schedule)
+	assert.Empty(t, resp.NextTick)
+
+	s = NewServer(OptionTick(5))
+	s.scheduler.Start(func() {})
+	defer s.scheduler.Stop()
+
+	resp, err = s.ServerStatus(context.Background(), &roveapi.ServerStatusRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "every 5m0s", resp.Schedule)
+	assert.Equal(t, int32(5), resp.TickRate)
+	next, err := time.Parse(time.RFC3339, resp.NextTick)
+	assert.NoError(t, err)
+	assert.True(t, next.After(time.Now()))
+}
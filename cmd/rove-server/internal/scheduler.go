@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+// Scheduler decides when the server ticks
+type Scheduler interface {
+	// Start calls tick on every scheduled tick until stopped
+	Start(tick func())
+
+	// Stop stops any further ticks
+	Stop()
+
+	// Next returns the time of the next tick, or the zero time if none is scheduled
+	Next() time.Time
+
+	// String describes the schedule
+	String() string
+}
+
+// ParseScheduler creates a scheduler from a description
+// This is either "manual", a duration such as "30s" or "10m", or a standard cron expression
+func ParseScheduler(desc string) (Scheduler, error) {
+	if desc == "manual" {
+		return NewManualScheduler(), nil
+	} else if d, err := time.ParseDuration(desc); err == nil {
+		return NewIntervalScheduler(d)
+	}
+	return NewCronScheduler(desc)
+}
+
+// intervalSchedule ticks on every multiple of a duration
+type intervalSchedule struct {
+	every time.Duration
+}
+
+// Next returns the next multiple of the duration after t, so ticks line up with the clock where they can
+func (i intervalSchedule) Next(t time.Time) time.Time {
+	return t.Truncate(i.every).Add(i.every)
+}
+
+// cronScheduler runs ticks with a cron job
+type cronScheduler struct {
+	cron     *cron.Cron
+	schedule cron.Schedule
+	desc     string
+}
+
+// NewIntervalScheduler creates a scheduler that ticks every interval, lined up with the clock
+// The interval must be a whole number of seconds
+func NewIntervalScheduler(every time.Duration) (Scheduler, error) {
+	if every < time.Second || every%time.Second != 0 {
+		return nil, fmt.Errorf("tick interval must be a whole number of seconds: %s", every)
+	}
+
+	return &cronScheduler{
+		cron:     cron.New(),
+		schedule: intervalSchedule{every: every},
+		desc:     fmt.Sprintf("every %s", every),
+	}, nil
+}
+
+// NewCronScheduler creates a scheduler that ticks on a standard cron expression
+func NewCronScheduler(spec string) (Scheduler, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tick schedule %q: %s", spec, err)
+	}
+
+	return &cronScheduler{
+		cron:     cron.New(),
+		schedule: schedule,
+		desc:     fmt.Sprintf("cron %s", spec),
+	}, nil
+}
+
+// Start begins the cron job
+func (c *cronScheduler) Start(tick func()) {
+	c.cron.Schedule(c.schedule, cron.FuncJob(tick))
+	c.cron.Start()
+}
+
+// Stop stops the cron job
+func (c *cronScheduler) Stop() {
+	c.cron.Stop()
+}
+
+// Next returns the time of the next cron run
+func (c *cronScheduler) Next() time.Time {
+	if entries := c.cron.Entries(); len(entries) > 0 {
+		return entries[0].Next
+	}
+	return time.Time{}
+}
+
+// String describes the schedule
+func (c *cronScheduler) String() string {
+	return c.desc
+}
+
+// manualScheduler never ticks by itself, ticks only happen when forced through the admin service
+type manualScheduler struct{}
+
+// NewManualScheduler creates a scheduler that never ticks by itself
+func NewManualScheduler() Scheduler {
+	return manualScheduler{}
+}
+
+// Start does nothing
+func (manualScheduler) Start(func()) {}
+
+// Stop does nothing
+func (manualScheduler) Stop() {}
+
+// Next returns the zero time as no tick is ever scheduled
+func (manualScheduler) Next() time.Time {
+	return time.Time{}
+}
+
+// String describes the schedule
+func (manualScheduler) String() string {
+	return "manual"
+}
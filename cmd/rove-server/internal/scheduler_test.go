@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScheduler(t *testing.T) {
+	s, err := ParseScheduler("manual")
+	assert.NoError(t, err)
+	assert.Equal(t, "manual", s.String())
+	assert.True(t, s.Next().IsZero())
+
+	s, err = ParseScheduler("30s")
+	assert.NoError(t, err)
+	assert.Equal(t, "every 30s", s.String())
+
+	s, err = ParseScheduler("*/5 * * * *")
+	assert.NoError(t, err)
+	assert.Equal(t, "cron */5 * * * *", s.String())
+
+	_, err = ParseScheduler("500ms")
+	assert.Error(t, err)
+	_, err = ParseScheduler("-1m")
+	assert.Error(t, err)
+	_, err = ParseScheduler("every now and then")
+	assert.Error(t, err)
+}
+
+func TestIntervalSchedule_Next(t *testing.T) {
+	now := time.Date(2020, 7, 1, 12, 3, 20, 0, time.UTC)
+
+	// Ticks line up with the clock
+	assert.Equal(t, time.Date(2020, 7, 1, 12, 5, 0, 0, time.UTC), intervalSchedule{every: 5 * time.Minute}.Next(now))
+	assert.Equal(t, time.Date(2020, 7, 1, 12, 3, 30, 0, time.UTC), intervalSchedule{every: 15 * time.Second}.Next(now))
+	assert.Equal(t, time.Date(2020, 7, 1, 13, 0, 0, 0, time.UTC), intervalSchedule{every: time.Hour}.Next(now))
+}
+
+func TestIntervalScheduler_Ticks(t *testing.T) {
+	s, err := NewIntervalScheduler(time.Second)
+	assert.NoError(t, err)
+
+	var ticks int32
+	s.Start(func() {
+		atomic.AddInt32(&ticks, 1)
+	})
+	next := s.Next()
+	assert.False(t, next.IsZero())
+	assert.True(t, next.After(time.Now()))
+
+	time.Sleep(1500 * time.Millisecond)
+	s.Stop()
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&ticks), int32(1))
+}
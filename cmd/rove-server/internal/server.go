@@ -1,24 +1,26 @@
 package internal
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net"
-	"os"
-	"path"
+	"net/http"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/mdiluz/rove/pkg/certs"
+	"github.com/mdiluz/rove/pkg/logging"
 	"github.com/mdiluz/rove/pkg/persistence"
 	"github.com/mdiluz/rove/pkg/rove"
+	"github.com/mdiluz/rove/proto/roveadmin"
 	"github.com/mdiluz/rove/proto/roveapi"
-	"github.com/robfig/cron"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-var cert = os.Getenv("CERT_NAME")
-
 const (
 	// PersistentData will allow the server to load and save it's state
 	PersistentData = iota
@@ -27,6 +29,9 @@ const (
 	EphemeralData
 )
 
+// defaultShutdownTimeout is how long in-flight RPCs get to finish when the server stops
+const defaultShutdownTimeout = 10 * time.Second
+
 // Server contains the relevant data to run a game server
 type Server struct {
 
@@ -38,15 +43,86 @@ type Server struct {
 	grpcServ    *grpc.Server
 
 	// Config settings
-	address        string
-	persistence    int
-	minutesPerTick int
+	address     string
+	persistence int
 
 	// sync point for sub-threads
 	sync sync.WaitGroup
 
-	// cron schedule for world ticks
-	schedule *cron.Cron
+	// schedule for world ticks
+	scheduler Scheduler
+
+	// active login sessions
+	sessions sessions
+
+	// inactivity policy, zero durations disable each step
+	retireAfter time.Duration
+	purgeAfter  time.Duration
+
+	// maximum rovers per account, 0 means no limit
+	fleetCap int
+
+	// world generation and timing, zero values keep the world defaults
+	chunkSize   int
+	seed        int64
+	ticksPerDay int
+
+	// gameplay rules, nil keeps the world's own rules
+	rules *rove.Rules
+
+	// crafting recipes, nil keeps the world's own recipes
+	recipes rove.Recipes
+
+	// TLS certificate and key files, TLS is disabled without them
+	certFile string
+	keyFile  string
+
+	// generate a self-signed certificate into the certificate and key files if they don't exist
+	selfSigned bool
+
+	// CA bundle to verify client certificates against, and whether clients must present one
+	clientCAFile      string
+	requireClientCert bool
+
+	// admin gRPC server, disabled without a token
+	adminAddress  string
+	adminToken    string
+	adminListener net.Listener
+	adminServ     *grpc.Server
+
+	// tickMutex stops scheduled and forced ticks overlapping
+	tickMutex sync.Mutex
+
+	// draining is set once the server starts stopping, accessed atomically
+	draining int32
+
+	// how long in-flight RPCs get to finish when stopping before they're cut off
+	shutdownTimeout time.Duration
+
+	// metrics, served over HTTP when given an address
+	metrics         *metrics
+	metricsAddress  string
+	metricsListener net.Listener
+	metricsServ     *http.Server
+
+	// rate limits for the game RPCs, nil leaves them unlimited
+	limiter *rateLimiter
+
+	// health of the server, reported through the gRPC health service
+	health *serverHealth
+
+	// spectator access, open to anyone without a token, and which rovers spectators see
+	spectatorToken  string
+	spectatorRovers string
+
+	// ticked wakes spectator streams after every tick
+	ticked tickNotifier
+
+	// logger is the root the subsystem loggers are named from
+	logger   *logging.Logger
+	log      *logging.Logger
+	rpcLog   *logging.Logger
+	adminLog *logging.Logger
 }
 
 // ServerOption defines a server creation option
@@ -70,7 +146,143 @@ func OptionPersistentData() ServerOption {
 // 0 means no automatic server tick
 func OptionTick(minutes int) ServerOption {
 	return func(s *Server) {
-		s.minutesPerTick = minutes
+		if minutes == 0 {
+			s.scheduler = NewManualScheduler()
+		} else if scheduler, err := NewIntervalScheduler(time.Duration(minutes) * time.Minute); err == nil {
+			s.scheduler = scheduler
+		}
+	}
+}
+
+// OptionScheduler sets the schedule for world ticks
+func OptionScheduler(scheduler Scheduler) ServerOption {
+	return func(s *Server) {
+		s.scheduler = scheduler
+	}
+}
+
+// OptionRetireAfter sets how long an account can be inactive before its rover is left dormant
+// 0 means rovers are never retired
+func OptionRetireAfter(d time.Duration) ServerOption {
+	return func(s *Server) {
+		s.retireAfter = d
+	}
+}
+
+// OptionPurgeAfter sets how long an account can be inactive before it is deleted
+// 0 means accounts are never purged
+func OptionPurgeAfter(d time.Duration) ServerOption {
+	return func(s *Server) {
+		s.purgeAfter = d
+	}
+}
+
+// OptionFleetCap sets the maximum number of rovers an account can own
+// 0 means no limit
+func OptionFleetCap(n int) ServerOption {
+	return func(s *Server) {
+		s.fleetCap = n
+	}
+}
+
+// OptionWorldGen sets the chunk size and seed for generating new worlds
+// Loaded worlds keep their own
+func OptionWorldGen(chunkSize int, seed int64) ServerOption {
+	return func(s *Server) {
+		s.chunkSize = chunkSize
+		s.seed = seed
+	}
+}
+
+// OptionTicksPerDay sets the number of ticks in a day
+func OptionTicksPerDay(n int) ServerOption {
+	return func(s *Server) {
+		s.ticksPerDay = n
+	}
+}
+
+// OptionRecipes sets the crafting recipes, replacing any stored with a loaded world
+func OptionRecipes(recipes rove.Recipes) ServerOption {
+	return func(s *Server) {
+		s.recipes = recipes
+	}
+}
+
+// OptionRules sets the gameplay rules, replacing any stored with a loaded world
+func OptionRules(rules rove.Rules) ServerOption {
+	return func(s *Server) {
+		s.rules = &rules
+	}
+}
+
+// OptionTLS enables TLS with a certificate and key file
+func OptionTLS(certFile string, keyFile string) ServerOption {
+	return func(s *Server) {
+		s.certFile = certFile
+		s.keyFile = keyFile
+	}
+}
+
+// OptionSelfSignedTLS generates a self-signed certificate for local play into the TLS files if they don't already exist
+// Clients can trust the server with the certificate file as their CA bundle
+func OptionSelfSignedTLS() ServerOption {
+	return func(s *Server) {
+		s.selfSigned = true
+	}
+}
+
+// OptionClientCA enables mutual TLS, verifying client certificates against a CA bundle
+// A verified certificate authenticates the account named by its common name, and can be required of every client
+func OptionClientCA(caFile string, require bool) ServerOption {
+	return func(s *Server) {
+		s.clientCAFile = caFile
+		s.requireClientCert = require
+	}
+}
+
+// OptionShutdownTimeout sets how long in-flight RPCs get to finish when the server stops
+func OptionShutdownTimeout(timeout time.Duration) ServerOption {
+	return func(s *Server) {
+		s.shutdownTimeout = timeout
+	}
+}
+
+// OptionMetrics serves Prometheus metrics over HTTP on an address
+func OptionMetrics(address string) ServerOption {
+	return func(s *Server) {
+		s.metricsAddress = address
+	}
+}
+
+// OptionLogger sets the logger the server, RPCs and world log through
+func OptionLogger(logger *logging.Logger) ServerOption {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// OptionRateLimits limits how often each account and client IP can call each RPC
+func OptionRateLimits(limits RateLimits) ServerOption {
+	return func(s *Server) {
+		s.limiter = newRateLimiter(limits)
+	}
+}
+
+// OptionSpectate sets the token spectators need and which rovers they see
+// An empty token lets anyone spectate
+func OptionSpectate(token string, rovers string) ServerOption {
+	return func(s *Server) {
+		s.spectatorToken = token
+		s.spectatorRovers = rovers
+	}
+}
+
+// OptionAdmin enables the admin service on its own address, guarded by a token
+// An empty token leaves the admin service disabled
+func OptionAdmin(address string, token string) ServerOption {
+	return func(s *Server) {
+		s.adminAddress = address
+		s.adminToken = token
 	}
 }
 
@@ -81,14 +293,27 @@ func NewServer(opts ...ServerOption) *Server {
 	s := &Server{
 		address:     "",
 		persistence: EphemeralData,
-		schedule:    cron.New(),
-		world:       rove.NewWorld(32),
+		scheduler:   NewManualScheduler(),
+		chunkSize:   32,
+		seed:        rove.DefaultSeed,
+		logger:      logging.Default(),
+		health:      newServerHealth(),
+
+		spectatorRovers: SpectateRoversOptIn,
+
+		shutdownTimeout: defaultShutdownTimeout,
 	}
 
 	// Apply all options
 	for _, o := range opts {
 		o(s)
 	}
+	s.log = s.logger.Named("server")
+	s.rpcLog = s.logger.Named("rpc")
+	s.adminLog = s.logger.Named("admin")
+	s.world = rove.NewSeededWorld(s.chunkSize, s.seed)
+	s.applyWorldOptions()
+	s.metrics = newMetrics(func() rove.WorldStats { return s.world.Stats() })
 
 	return s
 }
@@ -99,33 +324,78 @@ func (s *Server) Initialise(fillWorld bool) (err error) {
 	// Add to our sync
 	s.sync.Add(1)
 
-	// Load the world file
+	// Load the world file, the server isn't ready until it has
 	if err := s.LoadWorld(); err != nil {
 		return err
 	}
+	s.health.setLoaded(true)
+
+	// The configured options take priority over any saved ones
+	s.applyWorldOptions()
 
 	// Set up the RPC server and register
 	s.netListener, err = net.Listen("tcp", s.address)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		s.log.Fatal("Failed to listen", "address", s.address, "error", err)
 	}
 
-	// Load TLS
-	var opts []grpc.ServerOption
-	if len(os.Getenv("NO_TLS")) == 0 {
-		pem := path.Join("/etc/letsencrypt/live/", cert, "fullchain.pem")
-		key := path.Join("/etc/letsencrypt/live/", cert, "privkey.pem")
-		creds, err := credentials.NewServerTLSFromFile(pem, key)
+	// Load TLS, the admin service is guarded by its token so never asks for client certificates
+	var opts, adminOpts []grpc.ServerOption
+	if len(s.certFile) > 0 || len(s.keyFile) > 0 {
+		if s.selfSigned {
+			if err := certs.EnsureSelfSigned(s.certFile, s.keyFile, certs.LocalHosts); err != nil {
+				s.log.Fatal("Failed to setup self-signed TLS", "error", err)
+			}
+			s.log.Info("Serving with a self-signed certificate, clients can trust it as their CA", "cert", s.certFile)
+		}
+
+		config, err := certs.ServerConfig(s.certFile, s.keyFile, s.clientCAFile, s.requireClientCert)
 		if err != nil {
-			log.Fatalf("failed to setup TLS: %v", err)
+			s.log.Fatal("Failed to setup TLS", "error", err)
 		}
-		opts = append(opts, grpc.Creds(creds))
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config)))
+
+		adminConfig, err := certs.ServerConfig(s.certFile, s.keyFile, "", false)
+		if err != nil {
+			s.log.Fatal("Failed to setup admin TLS", "error", err)
+		}
+		adminOpts = append(adminOpts, grpc.Creds(credentials.NewTLS(adminConfig)))
 	}
 
-	s.grpcServ = grpc.NewServer(opts...)
+	s.grpcServ = grpc.NewServer(append(opts, grpc.ChainUnaryInterceptor(
+		s.metrics.interceptor,
+		errorInterceptor,
+		s.limiter.peerInterceptor,
+		s.authInterceptor,
+		s.limiter.accountInterceptor))...)
 	roveapi.RegisterRoveServer(s.grpcServ, s)
+	healthpb.RegisterHealthServer(s.grpcServ, s.health.server)
 	reflection.Register(s.grpcServ)
 
+	// Set up the admin server if enabled, without the account interceptors
+	if len(s.adminToken) > 0 {
+		s.adminListener, err = net.Listen("tcp", s.adminAddress)
+		if err != nil {
+			s.log.Fatal("Failed to listen for admin", "address", s.adminAddress, "error", err)
+		}
+
+		s.adminServ = grpc.NewServer(append(adminOpts,
+			grpc.MaxRecvMsgSize(maxAdminMessageSize),
+			grpc.MaxSendMsgSize(maxAdminMessageSize),
+			grpc.ChainUnaryInterceptor(s.metrics.interceptor, s.adminAuditInterceptor, errorInterceptor, s.adminAuthInterceptor))...)
+		roveadmin.RegisterRoveAdminServer(s.adminServ, &AdminServer{server: s})
+		reflection.Register(s.adminServ)
+	}
+
+	// Set up the metrics server if enabled
+	if len(s.metricsAddress) > 0 {
+		s.metricsListener, err = net.Listen("tcp", s.metricsAddress)
+		if err != nil {
+			s.log.Fatal("Failed to listen for metrics", "address", s.metricsAddress, "error", err)
+		}
+		s.metricsServ = &http.Server{Handler: s.metrics.handler()}
+	}
+
 	return nil
 }
 
@@ -138,47 +408,104 @@ func (s *Server) Addr() string {
 func (s *Server) Run() {
 	defer s.sync.Done()
 
-	// Set up the schedule if requested
-	if s.minutesPerTick != 0 {
-		if err := s.schedule.AddFunc(fmt.Sprintf("0 */%d * * *", s.minutesPerTick), func() {
-			// Ensure we don't quit during this function
-			s.sync.Add(1)
-			defer s.sync.Done()
-
-			log.Println("Executing server tick")
+	// Start the tick schedule
+	s.scheduler.Start(s.scheduledTick)
+	if !s.isDraining() {
+		s.health.setTicking(true)
+	}
+	if next := s.scheduler.Next(); !next.IsZero() {
+		s.log.Info("Ticking", "schedule", s.scheduler, "next", next.Format(time.RFC3339))
+	} else {
+		s.log.Info("Ticking", "schedule", s.scheduler)
+	}
 
-			// Tick the world
-			s.world.Tick()
+	// Serve the admin server alongside
+	if s.adminServ != nil {
+		s.sync.Add(1)
+		go func() {
+			defer s.sync.Done()
+			s.log.Info("Serving admin gRPC", "address", s.adminListener.Addr())
+			if err := s.adminServ.Serve(s.adminListener); err != nil && err != grpc.ErrServerStopped {
+				s.log.Fatal("Failed to serve admin gRPC", "error", err)
+			}
+		}()
+	}
 
-			// Save out the new world state
-			if err := s.SaveWorld(); err != nil {
-				log.Fatalf("Failed to save the world: %s", err)
+	// Serve the metrics alongside
+	if s.metricsServ != nil {
+		s.sync.Add(1)
+		go func() {
+			defer s.sync.Done()
+			s.log.Info("Serving metrics", "address", s.metricsListener.Addr(), "path", metricsPath)
+			if err := s.metricsServ.Serve(s.metricsListener); err != nil && err != http.ErrServerClosed {
+				s.log.Fatal("Failed to serve metrics", "error", err)
 			}
-		}); err != nil {
-			log.Fatal(err)
-		}
-		s.schedule.Start()
-		log.Printf("First server tick scheduled for %s\n", s.schedule.Entries()[0].Next.Format("15:04:05"))
+		}()
 	}
 
 	// Serve the RPC server
-	log.Printf("Serving gRPC on %s\n", s.address)
+	s.log.Info("Serving gRPC", "address", s.netListener.Addr())
 	if err := s.grpcServ.Serve(s.netListener); err != nil && err != grpc.ErrServerStopped {
-		log.Fatalf("failed to serve gRPC: %s", err)
+		s.log.Fatal("Failed to serve gRPC", "error", err)
 	}
 }
 
-// Stop will stop the current server
+// Stop drains and stops the current server
+// New commands are rejected straight away, any in-flight tick finishes and saves, then in-flight RPCs get until the
+// shutdown timeout to finish before they're cut off
 func (s *Server) Stop() error {
-	// Stop the cron
-	s.schedule.Stop()
+	atomic.StoreInt32(&s.draining, 1)
+
+	// Stop the tick schedule, then wait for any tick still running
+	s.scheduler.Stop()
+	s.health.setTicking(false)
+	s.tickMutex.Lock()
+	s.tickMutex.Unlock()
+
+	// Wake any spectator streams so they finish rather than wait for a tick that won't come
+	s.ticked.notify()
 
 	// Stop the gRPC
-	s.grpcServ.Stop()
+	deadline := time.Now().Add(s.shutdownTimeout)
+	s.gracefulStop(s.grpcServ, deadline)
+	if s.adminServ != nil {
+		s.gracefulStop(s.adminServ, deadline)
+	}
+
+	// Metrics are served right up until the end
+	if s.metricsServ != nil {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+		if err := s.metricsServ.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// gracefulStop stops a gRPC server once its in-flight RPCs finish, or stops it outright at the deadline
+func (s *Server) gracefulStop(serv *grpc.Server, deadline time.Time) {
+	done := make(chan struct{})
+	go func() {
+		serv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Until(deadline)):
+		s.log.Warn("Timed out waiting for RPCs to finish, stopping anyway")
+		serv.Stop()
+		<-done
+	}
+}
+
+// isDraining returns whether the server has started stopping
+func (s *Server) isDraining() bool {
+	return atomic.LoadInt32(&s.draining) != 0
+}
+
 // Close waits until the server is finished and closes up shop
 func (s *Server) Close() error {
 	// Wait until the world has shut down
@@ -199,14 +526,85 @@ func (s *Server) StopAndClose() error {
 	return s.Close()
 }
 
+// applyWorldOptions applies the configured options to the world
+func (s *Server) applyWorldOptions() {
+	s.world.FleetCap = s.fleetCap
+	if s.ticksPerDay > 0 {
+		s.world.TicksPerDay = s.ticksPerDay
+	}
+	if s.rules != nil {
+		if err := s.world.SetRules(*s.rules); err != nil {
+			s.log.Fatal("Failed to apply the rules", "error", err)
+		}
+	}
+	if s.recipes != nil {
+		if err := s.world.SetRecipes(s.recipes); err != nil {
+			s.log.Fatal("Failed to apply the recipes", "error", err)
+		}
+	}
+	s.world.SetLogger(s.logger.Named("world"))
+}
+
+// scheduledTick ticks and saves the world for the schedule
+// The tick lock is held throughout, so stopping the server waits for the save too
+func (s *Server) scheduledTick() {
+	s.tickMutex.Lock()
+	defer s.tickMutex.Unlock()
+
+	// No new ticks once the server is draining
+	if s.isDraining() {
+		return
+	}
+
+	s.log.Debug("Executing server tick", "tick", s.world.CurrentTicks)
+	s.tickLocked()
+
+	// Save out the new world state, failures are reported through the liveness health
+	if err := s.SaveWorld(); err != nil {
+		s.log.Error("Failed to save the world", "error", err)
+	}
+}
+
+// tick applies the inactivity policy and ticks the world once
+func (s *Server) tick() {
+	s.tickMutex.Lock()
+	defer s.tickMutex.Unlock()
+	s.tickLocked()
+}
+
+// tickLocked ticks the world once, the caller must hold the tick lock
+func (s *Server) tickLocked() {
+	start := time.Now()
+	defer func() {
+		s.metrics.tickDuration.Observe(time.Since(start).Seconds())
+	}()
+
+	// Retire or purge any idle accounts
+	if err := s.applyInactivityPolicy(time.Now()); err != nil {
+		s.log.Error("Failed to apply the inactivity policy", "error", err)
+	}
+
+	// Tick the world, then send the new state to spectators
+	s.world.Tick()
+	s.ticked.notify()
+}
+
 // SaveWorld will save out the world file
 func (s *Server) SaveWorld() error {
 	if s.persistence == PersistentData {
 		s.world.RLock()
 		defer s.world.RUnlock()
+		start := time.Now()
 		if err := persistence.SaveAll("world", s.world); err != nil {
+			s.health.setSaveError(err)
 			return fmt.Errorf("failed to save out persistent data: %s", err)
 		}
+		s.health.setSaveError(nil)
+		s.metrics.saveDuration.Observe(time.Since(start).Seconds())
+
+		if size, err := persistence.Size("world"); err == nil {
+			s.metrics.saveSize.Set(float64(size))
+		}
 	}
 	return nil
 }
@@ -1,8 +1,16 @@
 package internal
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
+	"path"
 	"testing"
+	"time"
+
+	"github.com/mdiluz/rove/pkg/persistence"
+	"github.com/mdiluz/rove/pkg/rove"
+	"github.com/mdiluz/rove/proto/roveapi"
 )
 
 func TestNewServer(t *testing.T) {
@@ -31,7 +39,6 @@ func TestNewServer_OptionPersistentData(t *testing.T) {
 }
 
 func TestServer_Run(t *testing.T) {
-	os.Setenv("NO_TLS", "1")
 	server := NewServer()
 	if server == nil {
 		t.Error("Failed to create server")
@@ -47,7 +54,6 @@ func TestServer_Run(t *testing.T) {
 }
 
 func TestServer_RunPersistentData(t *testing.T) {
-	os.Setenv("NO_TLS", "1")
 	server := NewServer(OptionPersistentData())
 	if server == nil {
 		t.Error("Failed to create server")
@@ -61,3 +67,111 @@ func TestServer_RunPersistentData(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestServer_RunSelfSignedTLS(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-tls-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile := path.Join(tmp, "cert.pem")
+
+	server := NewServer(OptionTLS(certFile, path.Join(tmp, "key.pem")), OptionSelfSignedTLS())
+	if err := server.Initialise(true); err != nil {
+		t.Error(err)
+	} else if _, err := os.Stat(certFile); err != nil {
+		t.Error("Failed to generate self-signed certificate")
+	}
+
+	go server.Run()
+
+	if err := server.StopAndClose(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestServer_StopDuringTick(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-stop-")
+	if err != nil {
+		t.Fatal(err)
+	} else if err := persistence.SetPath(tmp); err != nil {
+		t.Fatal(err)
+	}
+	defer persistence.SetPath(os.TempDir())
+
+	server := NewServer(OptionPersistentData(), OptionShutdownTimeout(time.Second))
+	if err := server.Initialise(true); err != nil {
+		t.Fatal(err)
+	}
+	go server.Run()
+
+	if _, err := server.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Hold the tick lock as if a tick were in flight, then stop
+	server.tickMutex.Lock()
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- server.Stop()
+	}()
+	for !server.isDraining() {
+		time.Sleep(time.Millisecond)
+	}
+
+	// New commands are rejected while the tick finishes
+	ctx := context.WithValue(context.Background(), accountKey{}, "test")
+	if _, err := server.Command(ctx, &roveapi.CommandRequest{}); err != errShuttingDown {
+		t.Errorf("Command accepted while draining: %v", err)
+	}
+
+	// Stopping waits for the tick to finish
+	select {
+	case <-stopped:
+		t.Error("Stopped before the tick finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+	server.world.Tick()
+	server.tickMutex.Unlock()
+
+	if err := <-stopped; err != nil {
+		t.Error(err)
+	} else if err := server.Close(); err != nil {
+		t.Error(err)
+	}
+
+	// The save has the whole tick and the account
+	saved := rove.NewWorld(8)
+	if err := persistence.LoadAll("world", &saved); err != nil {
+		t.Fatal(err)
+	} else if saved.CurrentTicks != 1 {
+		t.Errorf("Saved world has tick %d, expected 1", saved.CurrentTicks)
+	} else if len(saved.Fleet("test")) != 1 {
+		t.Error("Saved world is missing the account's rover")
+	}
+
+	// Scheduled ticks no longer run once stopped
+	server.scheduledTick()
+	if server.world.CurrentTicks != 1 {
+		t.Error("Scheduled tick ran after stopping")
+	}
+}
+
+func TestServer_RulesApplyToLoadedRovers(t *testing.T) {
+	server := NewServer()
+	if _, err := server.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Rules from the config replace those of a loaded world, along with the specifications they derive
+	rules := rove.DefaultRules()
+	rules.BaseRange = 12
+	server.rules = &rules
+	server.applyWorldOptions()
+
+	r, err := server.world.GetRover(server.world.Fleet("test")[0])
+	if err != nil {
+		t.Fatal(err)
+	} else if r.Range != 12 {
+		t.Errorf("Rover range not updated for the new rules: %d", r.Range)
+	}
+}
@@ -0,0 +1,186 @@
+package internal
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/mdiluz/rove/pkg/maths"
+	"github.com/mdiluz/rove/proto/roveapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// spectatorTokenMetadataKey is the metadata key for the spectator token
+	spectatorTokenMetadataKey = "rove-spectator-token"
+
+	// publicKey is the account data key for whether the account is shown to spectators
+	publicKey = "public"
+)
+
+// Which rovers are shown to spectators
+const (
+	// SpectateRoversAll shows every rover
+	SpectateRoversAll = "all"
+
+	// SpectateRoversOptIn only shows the rovers of accounts that made themselves public
+	SpectateRoversOptIn = "opt-in"
+
+	// SpectateRoversNone shows no rovers, only the world
+	SpectateRoversNone = "none"
+)
+
+// validSpectateRovers checks a spectator rover visibility
+func validSpectateRovers(rovers string) error {
+	switch rovers {
+	case SpectateRoversAll, SpectateRoversOptIn, SpectateRoversNone:
+		return nil
+	}
+	return fmt.Errorf("unknown spectator rover visibility, expected all, opt-in or none: %s", rovers)
+}
+
+// tickNotifier wakes everything waiting for the next tick
+type tickNotifier struct {
+	mutex sync.Mutex
+	next  chan struct{}
+}
+
+// wait returns a channel that's closed at the next tick
+func (n *tickNotifier) wait() <-chan struct{} {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if n.next == nil {
+		n.next = make(chan struct{})
+	}
+	return n.next
+}
+
+// notify wakes everything waiting
+func (n *tickNotifier) notify() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if n.next != nil {
+		close(n.next)
+		n.next = nil
+	}
+}
+
+// SetVisibility sets whether the authenticated account is shown to spectators for a gRPC request
+func (s *Server) SetVisibility(ctx context.Context, req *roveapi.SetVisibilityRequest) (*roveapi.SetVisibilityResponse, error) {
+	account := accountFromContext(ctx)
+	s.rpcLog.Info("Handling set visibility request", "account", account, "public", req.Public)
+
+	if err := s.world.Accountant.AssignData(account, publicKey, strconv.FormatBool(req.Public)); err != nil {
+		return nil, err
+	}
+	return &roveapi.SetVisibilityResponse{}, nil
+}
+
+// Spectate returns a view of the world for a gRPC request
+func (s *Server) Spectate(ctx context.Context, req *roveapi.SpectateRequest) (*roveapi.SpectateResponse, error) {
+	s.rpcLog.Info("Handling spectate request", "range", req.Range, "scale", req.Scale)
+
+	if err := s.authenticateSpectator(ctx); err != nil {
+		return nil, err
+	}
+	return s.spectate(req)
+}
+
+// WatchSpectate streams a view of the world for a gRPC request, straight away and then after every tick
+func (s *Server) WatchSpectate(req *roveapi.SpectateRequest, stream roveapi.Rove_WatchSpectateServer) error {
+	ctx := stream.Context()
+	s.rpcLog.Info("Handling watch spectate request", "range", req.Range, "scale", req.Scale)
+
+	// Streams skip the unary interceptors, so check the token and limits here
+	if err := s.authenticateSpectator(ctx); err != nil {
+		return err
+	} else if err := s.limiter.checkPeer(ctx, "WatchSpectate"); err != nil {
+		return errorStatus(err)
+	}
+
+	for {
+		// Start waiting before taking the view, so a tick in between isn't missed
+		next := s.ticked.wait()
+		if s.isDraining() {
+			return errorStatus(errShuttingDown)
+		}
+
+		resp, err := s.spectate(req)
+		if err != nil {
+			return errorStatus(err)
+		} else if err := stream.Send(resp); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-next:
+		}
+	}
+}
+
+// authenticateSpectator checks the spectator token, anyone can spectate when there isn't one
+func (s *Server) authenticateSpectator(ctx context.Context) error {
+	if len(s.spectatorToken) == 0 {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	token := firstValue(md, spectatorTokenMetadataKey)
+	if len(token) == 0 {
+		return status.Error(codes.Unauthenticated, "missing spectator token")
+	} else if subtle.ConstantTimeCompare([]byte(token), []byte(s.spectatorToken)) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid spectator token")
+	}
+	return nil
+}
+
+// spectate builds the view of the world for a request, with only the rovers spectators can see
+func (s *Server) spectate(req *roveapi.SpectateRequest) (*roveapi.SpectateResponse, error) {
+	scale := int(req.Scale)
+	if scale == 0 {
+		scale = 1
+	}
+
+	centre := maths.Vector{X: int(req.Centre.GetX()), Y: int(req.Centre.GetY())}
+	view, err := s.world.SpectateArea(centre, int(req.Range), scale)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &roveapi.SpectateResponse{
+		Tick:    int32(view.Tick),
+		Range:   req.Range,
+		Scale:   int32(scale),
+		Size:    int32(view.Size),
+		Tiles:   view.Tiles,
+		Objects: view.Objects,
+	}
+	for _, r := range view.Rovers {
+		if s.spectatorCanSee(r.Owner) {
+			resp.Rovers = append(resp.Rovers, &roveapi.SpectatorRover{
+				Name:     r.Name,
+				Account:  r.Owner,
+				Position: &roveapi.Vector{X: int32(r.Pos.X), Y: int32(r.Pos.Y)},
+			})
+		}
+	}
+	return resp, nil
+}
+
+// spectatorCanSee returns whether spectators are shown the rovers of an account
+func (s *Server) spectatorCanSee(account string) bool {
+	switch s.spectatorRovers {
+	case SpectateRoversAll:
+		return true
+	case SpectateRoversOptIn:
+		public, err := s.world.Accountant.GetValue(account, publicKey)
+		return err == nil && public == strconv.FormatBool(true)
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// spectateRover spectates the area around a rover and returns the names of the rovers seen
+func spectateRover(t *testing.T, s *Server, rover string) []string {
+	r, err := s.world.GetRover(rover)
+	assert.NoError(t, err)
+
+	resp, err := s.Spectate(context.Background(), &roveapi.SpectateRequest{
+		Centre: &roveapi.Vector{X: int32(r.Pos.X), Y: int32(r.Pos.Y)},
+		Range:  5,
+	})
+	assert.NoError(t, err)
+	if err != nil {
+		return nil
+	}
+	assert.Equal(t, int32(11), resp.Size)
+	assert.Equal(t, int32(1), resp.Scale)
+	assert.Len(t, resp.Tiles, 11*11)
+
+	var names []string
+	for _, r := range resp.Rovers {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+func TestServer_SpectateVisibility(t *testing.T) {
+	s := NewServer()
+	_, err := s.Register(context.Background(), &roveapi.RegisterRequest{Name: "test"})
+	assert.NoError(t, err)
+	rover := s.world.Fleet("test")[0]
+
+	// Accounts are private until they opt in
+	assert.Empty(t, spectateRover(t, s, rover))
+	ctx := context.WithValue(context.Background(), accountKey{}, "test")
+	_, err = s.SetVisibility(ctx, &roveapi.SetVisibilityRequest{Public: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{rover}, spectateRover(t, s, rover))
+	_, err = s.SetVisibility(ctx, &roveapi.SetVisibilityRequest{Public: false})
+	assert.NoError(t, err)
+	assert.Empty(t, spectateRover(t, s, rover))
+
+	// The server can override everyone's choice
+	s.spectatorRovers = SpectateRoversAll
+	assert.Equal(t, []string{rover}, spectateRover(t, s, rover))
+	s.spectatorRovers = SpectateRoversNone
+	_, err = s.SetVisibility(ctx, &roveapi.SetVisibilityRequest{Public: true})
+	assert.NoError(t, err)
+	assert.Empty(t, spectateRover(t, s, rover))
+
+	// Views too big for the scale are rejected
+	_, err = s.Spectate(context.Background(), &roveapi.SpectateRequest{Range: 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(errorStatus(err)))
+	resp, err := s.Spectate(context.Background(), &roveapi.SpectateRequest{Range: 1000, Scale: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(21), resp.Size)
+}
+
+func TestServer_SpectateToken(t *testing.T) {
+	s := NewServer(OptionSpectate("watch", SpectateRoversOptIn))
+
+	_, err := s.Spectate(context.Background(), &roveapi.SpectateRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(spectatorTokenMetadataKey, "wrong"))
+	_, err = s.Spectate(ctx, &roveapi.SpectateRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(spectatorTokenMetadataKey, "watch"))
+	_, err = s.Spectate(ctx, &roveapi.SpectateRequest{})
+	assert.NoError(t, err)
+}
+
+func TestServer_WatchSpectate(t *testing.T) {
+	s := NewServer(OptionAddress("localhost:0"))
+	assert.NoError(t, s.Initialise(true))
+	go s.Run()
+
+	conn, err := grpc.Dial(s.netListener.Addr().String(), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := roveapi.NewRoveClient(conn)
+
+	// Spectators need no account
+	stream, err := client.WatchSpectate(context.Background(), &roveapi.SpectateRequest{Range: 2})
+	assert.NoError(t, err)
+
+	// The first view comes straight away, then one more for every tick
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Tick)
+	s.tick()
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Tick)
+
+	// Streams end when the server stops instead of holding it up
+	assert.NoError(t, s.StopAndClose())
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
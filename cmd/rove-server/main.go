@@ -2,27 +2,23 @@ package main
 
 import (
 	"flag"
-	"fmt"
 	"log"
 	"math/rand"
 	"os"
 	"os/signal"
-	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/mdiluz/rove/cmd/rove-server/internal"
 	"github.com/mdiluz/rove/pkg/persistence"
+	"github.com/mdiluz/rove/pkg/rove"
 	"github.com/mdiluz/rove/pkg/version"
 )
 
 var ver = flag.Bool("version", false, "Display version number")
 
-// Path for persistent storage
-var data = os.Getenv("DATA_PATH")
-
-// The tick rate of the server in seconds
-var tick = os.Getenv("TICK_RATE")
+// All other settings come from a config file, the environment, or flags
+var config = internal.NewConfigLoader(flag.CommandLine)
 
 // InnerMain is our main function so tests can run it
 func InnerMain() {
@@ -37,42 +33,40 @@ func InnerMain() {
 		return
 	}
 
-	// Address to host the server on
-	var iport int
-	var port = os.Getenv("PORT")
-	if len(port) == 0 {
-		iport = 9090
-	} else {
-		var err error
-		iport, err = strconv.Atoi(port)
-		if err != nil {
-			log.Fatal("$PORT not valid int")
-		}
+	// Load the config
+	cfg, err := config.Load(os.Getenv)
+	if err != nil {
+		log.Fatal(err)
 	}
-	log.Printf("Initialising version %s...\n", version.Version)
 
-	// Set the persistence path
-	if len(data) == 0 {
-		log.Fatal("DATA_PATH not set")
-	} else if err := persistence.SetPath(data); err != nil {
+	// Set up logging for everything
+	logger, err := cfg.Logger(os.Stderr)
+	if err != nil {
 		log.Fatal(err)
 	}
+	mainLog := logger.Named("server")
+	mainLog.Info("Initialising", "version", version.Version)
+	persistence.SetLogger(logger.Named("persistence"))
 
-	// Convert the tick rate
-	tickRate := 1
-	if len(tick) > 0 {
-		var err error
-		tickRate, err = strconv.Atoi(tick)
-		if err != nil {
-			log.Fatalf("TICK_RATE not set to valid int: %s", err)
+	// Set the persistence path
+	if cfg.Storage.Backend == internal.StorageFile {
+		if err := persistence.SetPath(cfg.Storage.Path); err != nil {
+			mainLog.Fatal("Failed to set the storage path", "error", err)
+		}
+	}
+
+	if len(cfg.World.WordsFile) > 0 {
+		if err := rove.SetWordsFile(cfg.World.WordsFile); err != nil {
+			mainLog.Warn("Running without rover name words", "error", err)
 		}
 	}
 
 	// Create the server data
-	s := internal.NewServer(
-		internal.OptionAddress(fmt.Sprintf(":%d", iport)),
-		internal.OptionPersistentData(),
-		internal.OptionTick(tickRate))
+	opts, err := cfg.Options()
+	if err != nil {
+		mainLog.Fatal("Failed to create server options", "error", err)
+	}
+	s := internal.NewServer(append(opts, internal.OptionLogger(logger))...)
 
 	// Initialise the server
 	if err := s.Initialise(true); err != nil {
@@ -80,20 +74,25 @@ func InnerMain() {
 	}
 
 	// Set up the close handler
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
+	stopped := make(chan error, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-c
-		log.Println("Quit requested, exiting...")
-		if err := s.Stop(); err != nil {
-			panic(err)
-		}
+		mainLog.Info("Quit requested, draining...")
+		stopped <- s.Stop()
 	}()
 
 	// Run the server
 	s.Run()
 
+	// Wait for the server to drain before the final save
+	if err := <-stopped; err != nil {
+		panic(err)
+	}
+
 	// Close the server
+	mainLog.Info("Drained, exiting...")
 	if err := s.Close(); err != nil {
 		panic(err)
 	}
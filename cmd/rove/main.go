@@ -1,7 +1,6 @@
 package main
 
 import (
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -10,14 +9,17 @@ import (
 	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/mdiluz/rove/cmd/rove/internal"
+	"github.com/mdiluz/rove/pkg/certs"
+	"github.com/mdiluz/rove/pkg/glyph"
 	"github.com/mdiluz/rove/pkg/version"
 	"github.com/mdiluz/rove/proto/roveapi"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
 var home = os.Getenv("HOME")
@@ -30,25 +32,43 @@ func printUsage() {
 	fmt.Fprintln(os.Stderr, "Arguments:")
 	fmt.Fprintln(os.Stderr, "\tversion                       outputs version")
 	fmt.Fprintln(os.Stderr, "\thelp                          outputs this usage text")
-	fmt.Fprintln(os.Stderr, "\tconfig [HOST]                 outputs the local config, optionally sets host")
+	fmt.Fprintln(os.Stderr, "\tconfig [HOST [CA [CERT KEY]]] outputs the local config, optionally sets host, CA bundle and client certificate")
 	fmt.Fprintln(os.Stderr, "\tserver-status                 prints the server status")
 	fmt.Fprintln(os.Stderr, "\tregister NAME                 registers an account and spawns a rover")
-	fmt.Fprintln(os.Stderr, "\tradar                         prints radar data in ASCII form")
-	fmt.Fprintln(os.Stderr, "\tstatus                        gets rover status")
+	fmt.Fprintln(os.Stderr, "\trotate-secret                 replaces the account secret with a new one")
+	fmt.Fprintln(os.Stderr, "\tdelete-account NAME           deletes the account, leaving the rover dormant")
+	fmt.Fprintln(os.Stderr, "\tfleet [ROVER]                 lists the rovers in the fleet, optionally selects one for future requests")
+	fmt.Fprintln(os.Stderr, "\tradar [ROVER]                 prints radar data in ASCII form")
+	fmt.Fprintln(os.Stderr, "\tstatus [ROVER]                gets rover status")
+	fmt.Fprintln(os.Stderr, "\tmap [RANGE]                   prints the map explored by the team in ASCII form")
+	fmt.Fprintln(os.Stderr, "\tspectate [RANGE [SCALE]]      prints the generated world around the origin in ASCII form, sampled every SCALE tiles")
+	fmt.Fprintln(os.Stderr, "\tvisibility public|private     sets whether spectators can see the account's rovers")
+	fmt.Fprintln(os.Stderr, "\tteam create|join NAME         creates or joins a team")
+	fmt.Fprintln(os.Stderr, "\tteam leave                    leaves the current team")
+	fmt.Fprintln(os.Stderr, "\tteam message MSG...           sends a message to every rover in the team")
 	fmt.Fprintln(os.Stderr, "\tcommand CMD [VAL...] [REPEAT] sets the command queue, accepts multiple in sequence")
 	fmt.Fprintf(os.Stderr, "\n")
 	fmt.Fprintln(os.Stderr, "Rover commands:")
-	fmt.Fprintln(os.Stderr, "\ttoggle         toggles the current sail mode")
-	fmt.Fprintln(os.Stderr, "\tstash          stores the object at the rover location in the inventory")
-	fmt.Fprintln(os.Stderr, "\trepair         repairs the rover using inventory item")
-	fmt.Fprintln(os.Stderr, "\tbroadcast MSG  broadcast a simple ASCII triplet to nearby rovers")
-	fmt.Fprintln(os.Stderr, "\tsalvage        salvages a dormant rover for parts")
-	fmt.Fprintln(os.Stderr, "\ttransfer       transfer's control into a dormant rover")
-	fmt.Fprintln(os.Stderr, "\tupgrade SPEC   spends rover parts to upgrade one rover spec (capacity, range, integrity, charge")
-	fmt.Fprintln(os.Stderr, "\twait           waits before performing the next command")
+	fmt.Fprintln(os.Stderr, "\ttoggle              toggles the current sail mode")
+	fmt.Fprintln(os.Stderr, "\tstash               stores the object at the rover location in the inventory")
+	fmt.Fprintln(os.Stderr, "\trepair              repairs the rover using inventory item")
+	fmt.Fprintln(os.Stderr, "\tbroadcast MSG       broadcast a short message to nearby rovers, the server sets the maximum length")
+	fmt.Fprintln(os.Stderr, "\tsalvage             salvages a dormant rover for parts")
+	fmt.Fprintln(os.Stderr, "\ttransfer            transfer's control into a dormant rover")
+	fmt.Fprintln(os.Stderr, "\tclaim               claims a dormant rover into the fleet")
+	fmt.Fprintln(os.Stderr, "\tinstall ITEM        installs an inventory component (by object name or index) into its equipment slot")
+	fmt.Fprintln(os.Stderr, "\tuninstall SLOT      uninstalls the component in an equipment slot (antenna, battery, hull, sail, drill)")
+	fmt.Fprintln(os.Stderr, "\tdrop ITEM [B]       drops an inventory item (by object name or index), optionally onto the adjacent tile at bearing B")
+	fmt.Fprintln(os.Stderr, "\tcraft RECIPE        crafts a component from inventory items with one of the server's recipes, such as hull-plate")
+	fmt.Fprintln(os.Stderr, "\tbuild S [MSG] [B]   builds a structure (beacon MSG, station, cache), optionally onto the adjacent tile at bearing B")
+	fmt.Fprintln(os.Stderr, "\tdeposit ITEM [B]    deposits an inventory item (by object name or index) into a cache, optionally at bearing B")
+	fmt.Fprintln(os.Stderr, "\twithdraw [ITEM] [B] withdraws an item from a cache, optionally of a specific object name and at bearing B")
+	fmt.Fprintln(os.Stderr, "\twait                waits before performing the next command")
 	fmt.Fprintf(os.Stderr, "\n")
 	fmt.Fprintln(os.Stderr, "Environment")
 	fmt.Fprintln(os.Stderr, "\tROVE_USER_DATA        path to user data, defaults to "+defaultDataPath)
+	fmt.Fprintln(os.Stderr, "\tNO_TLS                disables TLS when set")
+	fmt.Fprintln(os.Stderr, "\tROVE_SPECTATOR_TOKEN  token for servers that only allow some spectators")
 }
 
 const gRPCport = 9090
@@ -63,6 +83,17 @@ type Account struct {
 type Config struct {
 	Host    string
 	Account Account
+
+	// Rover is the selected rover from the fleet, the server picks the first if empty
+	Rover string
+
+	// CAFile is a CA bundle to verify the server with, such as a self-signed server certificate
+	// The system roots are used when empty
+	CAFile string
+
+	// CertFile and KeyFile are a client certificate to present to servers using mutual TLS
+	CertFile string
+	KeyFile  string
 }
 
 // ConfigPath returns the configuration path
@@ -132,29 +163,6 @@ func checkAccount(a Account) error {
 	return nil
 }
 
-// BearingFromString converts a string to a bearing
-func BearingFromString(s string) roveapi.Bearing {
-	switch s {
-	case "N":
-		return roveapi.Bearing_North
-	case "NE":
-		return roveapi.Bearing_NorthEast
-	case "E":
-		return roveapi.Bearing_East
-	case "SE":
-		return roveapi.Bearing_SouthEast
-	case "S":
-		return roveapi.Bearing_South
-	case "SW":
-		return roveapi.Bearing_SouthWest
-	case "W":
-		return roveapi.Bearing_West
-	case "NW":
-		return roveapi.Bearing_NorthWest
-	}
-	return roveapi.Bearing_BearingUnknown
-}
-
 // InnerMain wraps the main function so we can test it
 func InnerMain(command string, args ...string) error {
 
@@ -179,7 +187,17 @@ func InnerMain(command string, args ...string) error {
 		if len(args) > 0 {
 			config.Host = args[0]
 		}
+		if len(args) > 1 {
+			config.CAFile = args[1]
+		}
+		if len(args) > 3 {
+			config.CertFile = args[2]
+			config.KeyFile = args[3]
+		}
 		fmt.Printf("host: %s\taccount: %s\n", config.Host, config.Account)
+		if len(config.CAFile) > 0 || len(config.CertFile) > 0 {
+			fmt.Printf("ca: %s\tcert: %s\tkey: %s\n", config.CAFile, config.CertFile, config.KeyFile)
+		}
 		return SaveConfig(config)
 	}
 
@@ -190,7 +208,11 @@ func InnerMain(command string, args ...string) error {
 
 	var opts []grpc.DialOption
 	if len(os.Getenv("NO_TLS")) == 0 {
-		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})))
+		tlsConfig, err := certs.ClientConfig(config.CAFile, config.CertFile, config.KeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to set up TLS: %s", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	} else {
 		opts = append(opts, grpc.WithInsecure())
 	}
@@ -204,6 +226,9 @@ func InnerMain(command string, args ...string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// Authenticate all requests with the account credentials
+	ctx = metadata.AppendToOutgoingContext(ctx, "rove-account", config.Account.Name, "rove-secret", config.Account.Secret)
+
 	// Handle all the commands
 	switch command {
 	case "server-status":
@@ -215,7 +240,7 @@ func InnerMain(command string, args ...string) error {
 		default:
 			fmt.Printf("Ready: %t\n", response.Ready)
 			fmt.Printf("Version: %s\n", response.Version)
-			fmt.Printf("Tick Rate: %d\n", response.TickRate)
+			fmt.Printf("Schedule: %s\n", response.Schedule)
 			fmt.Printf("Current Tick: %d\n", response.CurrentTick)
 			fmt.Printf("Next Tick: %s\n", response.NextTick)
 		}
@@ -236,6 +261,74 @@ func InnerMain(command string, args ...string) error {
 			fmt.Printf("Registered account with id: %s\n", resp.Account.Name)
 			config.Account.Name = resp.Account.Name
 			config.Account.Secret = resp.Account.Secret
+			config.Rover = ""
+		}
+
+	case "rotate-secret":
+		if err := checkAccount(config.Account); err != nil {
+			return err
+		}
+
+		resp, err := client.RotateSecret(ctx, &roveapi.RotateSecretRequest{})
+		switch {
+		case err != nil:
+			return err
+
+		default:
+			fmt.Printf("Rotated secret for account: %s\n", resp.Account.Name)
+			config.Account.Secret = resp.Account.Secret
+		}
+
+	case "delete-account":
+		if err := checkAccount(config.Account); err != nil {
+			return err
+		} else if len(args) == 0 || args[0] != config.Account.Name {
+			return fmt.Errorf("must pass the current account name to 'delete-account' to confirm")
+		}
+
+		_, err := client.DeleteAccount(ctx, &roveapi.DeleteAccountRequest{})
+		switch {
+		case err != nil:
+			return err
+
+		default:
+			fmt.Printf("Deleted account: %s\n", config.Account.Name)
+			config.Account = Account{}
+			config.Rover = ""
+		}
+
+	case "fleet":
+		if err := checkAccount(config.Account); err != nil {
+			return err
+		}
+
+		response, err := client.Fleet(ctx, &roveapi.FleetRequest{})
+		switch {
+		case err != nil:
+			return err
+
+		case len(args) > 0:
+			// Select a rover from the fleet
+			for _, r := range response.Rovers {
+				if r == args[0] {
+					config.Rover = r
+					fmt.Printf("Selected rover: %s\n", r)
+					return SaveConfig(config)
+				}
+			}
+			return fmt.Errorf("rover %s not in fleet", args[0])
+
+		default:
+			for _, r := range response.Rovers {
+				if r == config.Rover {
+					fmt.Printf("%s (selected)\n", r)
+				} else {
+					fmt.Println(r)
+				}
+			}
+			if response.Cap > 0 {
+				fmt.Printf("%d/%d rovers\n", len(response.Rovers), response.Cap)
+			}
 		}
 
 	case "command":
@@ -256,7 +349,7 @@ func InnerMain(command string, args ...string) error {
 				if len(args) == i {
 					return fmt.Errorf("turn command must be passed a compass bearing")
 				}
-				b := BearingFromString(args[i])
+				b := glyph.BearingFromString(args[i])
 				if b == roveapi.Bearing_BearingUnknown {
 					return fmt.Errorf("turn command must be given a valid bearing %s", args[i])
 				}
@@ -267,35 +360,154 @@ func InnerMain(command string, args ...string) error {
 			case "broadcast":
 				i++
 				if len(args) == i {
-					return fmt.Errorf("broadcast command must be passed an ASCII triplet")
-				} else if len(args[i]) > 3 {
-					return fmt.Errorf("broadcast command must be given ASCII triplet of 3 or less: %s", args[i])
+					return fmt.Errorf("broadcast command must be passed a message")
 				}
 				cmd = &roveapi.Command{
 					Command: roveapi.CommandType_broadcast,
 					Data:    []byte(args[i]),
 				}
-			case "upgrade":
+			case "install":
 				i++
 				if len(args) == i {
-					return fmt.Errorf("upgrade command must be passed a spec to upgrade")
+					return fmt.Errorf("install command must be passed an object name or inventory index")
+				}
+				cmd = &roveapi.Command{
+					Command: roveapi.CommandType_install,
 				}
-				var u roveapi.RoverUpgrade
+				if index, err := strconv.Atoi(args[i]); err == nil {
+					cmd.Index = int32(index)
+				} else if o, ok := roveapi.Object_value[args[i]]; ok {
+					cmd.Object = roveapi.Object(o)
+				} else {
+					return fmt.Errorf("install command must be given a valid object name or inventory index: %s", args[i])
+				}
+			case "uninstall":
+				i++
+				if len(args) == i {
+					return fmt.Errorf("uninstall command must be passed an equipment slot")
+				}
+				var slot roveapi.ComponentSlot
 				switch args[i] {
-				case "capacity":
-					u = roveapi.RoverUpgrade_Capacity
-				case "range":
-					u = roveapi.RoverUpgrade_Range
-				case "integrity":
-					u = roveapi.RoverUpgrade_MaximumIntegrity
-				case "charge":
-					u = roveapi.RoverUpgrade_MaximumCharge
+				case "antenna":
+					slot = roveapi.ComponentSlot_Antenna
+				case "battery":
+					slot = roveapi.ComponentSlot_Battery
+				case "hull":
+					slot = roveapi.ComponentSlot_Hull
+				case "sail":
+					slot = roveapi.ComponentSlot_Sail
+				case "drill":
+					slot = roveapi.ComponentSlot_Drill
 				default:
-					return fmt.Errorf("upgrade command must be passed a known upgrade spec")
+					return fmt.Errorf("uninstall command must be passed a known equipment slot")
+				}
+				cmd = &roveapi.Command{
+					Command: roveapi.CommandType_uninstall,
+					Slot:    slot,
+				}
+			case "craft":
+				i++
+				if len(args) == i {
+					return fmt.Errorf("craft command must be passed a recipe")
 				}
 				cmd = &roveapi.Command{
-					Command: roveapi.CommandType_upgrade,
-					Upgrade: u,
+					Command: roveapi.CommandType_craft,
+					Recipe:  args[i],
+				}
+			case "build":
+				i++
+				if len(args) == i {
+					return fmt.Errorf("build command must be passed a structure")
+				}
+				cmd = &roveapi.Command{
+					Command: roveapi.CommandType_build,
+				}
+				switch args[i] {
+				case "beacon":
+					cmd.Object = roveapi.Object_Beacon
+					i++
+					if len(args) == i {
+						return fmt.Errorf("build beacon must be passed a message")
+					}
+					cmd.Data = []byte(args[i])
+				case "station":
+					cmd.Object = roveapi.Object_SolarStation
+				case "cache":
+					cmd.Object = roveapi.Object_Cache
+				default:
+					return fmt.Errorf("build command must be passed a known structure")
+				}
+
+				// Optionally take a bearing to build on an adjacent tile
+				if len(args) > i+1 {
+					if b := glyph.BearingFromString(args[i+1]); b != roveapi.Bearing_BearingUnknown {
+						cmd.Bearing = b
+						i++
+					}
+				}
+			case "deposit":
+				i++
+				if len(args) == i {
+					return fmt.Errorf("deposit command must be passed an object name or inventory index")
+				}
+				cmd = &roveapi.Command{
+					Command: roveapi.CommandType_deposit,
+				}
+				if index, err := strconv.Atoi(args[i]); err == nil {
+					cmd.Index = int32(index)
+				} else if o, ok := roveapi.Object_value[args[i]]; ok {
+					cmd.Object = roveapi.Object(o)
+				} else {
+					return fmt.Errorf("deposit command must be given a valid object name or inventory index: %s", args[i])
+				}
+
+				// Optionally take a bearing to deposit into an adjacent cache
+				if len(args) > i+1 {
+					if b := glyph.BearingFromString(args[i+1]); b != roveapi.Bearing_BearingUnknown {
+						cmd.Bearing = b
+						i++
+					}
+				}
+			case "withdraw":
+				cmd = &roveapi.Command{
+					Command: roveapi.CommandType_withdraw,
+				}
+
+				// Optionally take an object type and a bearing for an adjacent cache
+				if len(args) > i+1 {
+					if o, ok := roveapi.Object_value[args[i+1]]; ok {
+						cmd.Object = roveapi.Object(o)
+						i++
+					}
+				}
+				if len(args) > i+1 {
+					if b := glyph.BearingFromString(args[i+1]); b != roveapi.Bearing_BearingUnknown {
+						cmd.Bearing = b
+						i++
+					}
+				}
+			case "drop":
+				i++
+				if len(args) == i {
+					return fmt.Errorf("drop command must be passed an object name or inventory index")
+				}
+				cmd = &roveapi.Command{
+					Command: roveapi.CommandType_drop,
+				}
+				if index, err := strconv.Atoi(args[i]); err == nil {
+					cmd.Index = int32(index)
+				} else if o, ok := roveapi.Object_value[args[i]]; ok {
+					cmd.Object = roveapi.Object(o)
+				} else {
+					return fmt.Errorf("drop command must be given a valid object name or inventory index: %s", args[i])
+				}
+
+				// Optionally take a bearing to drop onto an adjacent tile
+				if len(args) > i+1 {
+					if b := glyph.BearingFromString(args[i+1]); b != roveapi.Bearing_BearingUnknown {
+						cmd.Bearing = b
+						i++
+					}
 				}
 			default:
 				// By default just use the command literally
@@ -319,10 +531,7 @@ func InnerMain(command string, args ...string) error {
 		}
 
 		_, err := client.Command(ctx, &roveapi.CommandRequest{
-			Account: &roveapi.Account{
-				Name:   config.Account.Name,
-				Secret: config.Account.Secret,
-			},
+			Rover:    config.Rover,
 			Commands: commands,
 		})
 
@@ -339,12 +548,12 @@ func InnerMain(command string, args ...string) error {
 			return err
 		}
 
-		response, err := client.Radar(ctx, &roveapi.RadarRequest{
-			Account: &roveapi.Account{
-				Name:   config.Account.Name,
-				Secret: config.Account.Secret,
-			},
-		})
+		rover := config.Rover
+		if len(args) > 0 {
+			rover = args[0]
+		}
+
+		response, err := client.Radar(ctx, &roveapi.RadarRequest{Rover: rover})
 
 		switch {
 		case err != nil:
@@ -359,14 +568,160 @@ func InnerMain(command string, args ...string) error {
 					t := response.Tiles[i+num*j]
 					o := response.Objects[i+num*j]
 					if o != roveapi.Object_ObjectUnknown {
-						fmt.Printf("%c", internal.ObjectGlyph(o))
+						fmt.Printf("%c", glyph.ObjectGlyph(o))
 					} else {
-						fmt.Printf("%c", internal.TileGlyph(t))
+						fmt.Printf("%c", glyph.TileGlyph(t))
 					}
 
 				}
 				fmt.Print("\n")
 			}
+
+			// Print out where the rest of the team is
+			for _, r := range response.Teammates {
+				fmt.Printf("%s (%s) at %d,%d\n", r.Name, r.Account, r.Position.X, r.Position.Y)
+			}
+		}
+
+	case "map":
+		if err := checkAccount(config.Account); err != nil {
+			return err
+		}
+
+		rng := 10
+		if len(args) > 0 {
+			var err error
+			if rng, err = strconv.Atoi(args[0]); err != nil {
+				return fmt.Errorf("map range must be a number: %s", args[0])
+			}
+		}
+
+		response, err := client.Map(ctx, &roveapi.MapRequest{Rover: config.Rover, Range: int32(rng)})
+
+		switch {
+		case err != nil:
+			return err
+
+		default:
+			// Print out the map, leaving unexplored tiles blank
+			num := int(math.Sqrt(float64(len(response.Tiles))))
+			for j := num - 1; j >= 0; j-- {
+				for i := 0; i < num; i++ {
+					t := response.Tiles[i+num*j]
+					o := response.Objects[i+num*j]
+					if o != roveapi.Object_ObjectUnknown {
+						fmt.Printf("%c", glyph.ObjectGlyph(o))
+					} else if t != roveapi.Tile_TileUnknown {
+						fmt.Printf("%c", glyph.TileGlyph(t))
+					} else {
+						fmt.Print(" ")
+					}
+				}
+				fmt.Print("\n")
+			}
+		}
+
+	case "spectate":
+		rng, scale := 10, 1
+		for i, v := range []*int{&rng, &scale} {
+			if len(args) > i {
+				var err error
+				if *v, err = strconv.Atoi(args[i]); err != nil {
+					return fmt.Errorf("spectate range and scale must be numbers: %s", args[i])
+				}
+			}
+		}
+
+		// Servers may only allow spectators with a token
+		if token := os.Getenv("ROVE_SPECTATOR_TOKEN"); len(token) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, "rove-spectator-token", token)
+		}
+
+		response, err := client.Spectate(ctx, &roveapi.SpectateRequest{Centre: &roveapi.Vector{}, Range: int32(rng), Scale: int32(scale)})
+
+		switch {
+		case err != nil:
+			return err
+
+		default:
+			// Print out the world, leaving ungenerated tiles blank
+			num := int(response.Size)
+			for j := num - 1; j >= 0; j-- {
+				for i := 0; i < num; i++ {
+					t := response.Tiles[i+num*j]
+					o := response.Objects[i+num*j]
+					if o != roveapi.Object_ObjectUnknown {
+						fmt.Printf("%c", glyph.ObjectGlyph(o))
+					} else if t != roveapi.Tile_TileUnknown {
+						fmt.Printf("%c", glyph.TileGlyph(t))
+					} else {
+						fmt.Print(" ")
+					}
+				}
+				fmt.Print("\n")
+			}
+
+			// Print out the rovers the server shows spectators
+			for _, r := range response.Rovers {
+				fmt.Printf("%s (%s) at %d,%d\n", r.Name, r.Account, r.Position.X, r.Position.Y)
+			}
+		}
+
+	case "visibility":
+		if err := checkAccount(config.Account); err != nil {
+			return err
+		} else if len(args) == 0 || (args[0] != "public" && args[0] != "private") {
+			return fmt.Errorf("must pass public or private to 'visibility'")
+		}
+
+		_, err := client.SetVisibility(ctx, &roveapi.SetVisibilityRequest{Public: args[0] == "public"})
+		switch {
+		case err != nil:
+			return err
+
+		default:
+			fmt.Printf("Request succeeded\n")
+		}
+
+	case "team":
+		if err := checkAccount(config.Account); err != nil {
+			return err
+		} else if len(args) == 0 {
+			return fmt.Errorf("must pass create, join, leave or message to 'team'")
+		}
+
+		var response *roveapi.TeamResponse
+		var err error
+		switch args[0] {
+		case "create", "join":
+			if len(args) < 2 {
+				return fmt.Errorf("team %s must be passed a team name", args[0])
+			}
+			if args[0] == "create" {
+				response, err = client.CreateTeam(ctx, &roveapi.CreateTeamRequest{Name: args[1]})
+			} else {
+				response, err = client.JoinTeam(ctx, &roveapi.JoinTeamRequest{Name: args[1]})
+			}
+		case "leave":
+			_, err = client.LeaveTeam(ctx, &roveapi.LeaveTeamRequest{})
+		case "message":
+			if len(args) < 2 {
+				return fmt.Errorf("team message must be passed a message")
+			}
+			_, err = client.TeamMessage(ctx, &roveapi.TeamMessageRequest{Message: strings.Join(args[1:], " ")})
+		default:
+			return fmt.Errorf("unknown team command: %s", args[0])
+		}
+
+		switch {
+		case err != nil:
+			return err
+
+		case response != nil:
+			fmt.Printf("Team %s: %s\n", response.Name, strings.Join(response.Members, ", "))
+
+		default:
+			fmt.Printf("Request succeeded\n")
 		}
 
 	case "status":
@@ -374,12 +729,12 @@ func InnerMain(command string, args ...string) error {
 			return err
 		}
 
-		response, err := client.Status(ctx, &roveapi.StatusRequest{
-			Account: &roveapi.Account{
-				Name:   config.Account.Name,
-				Secret: config.Account.Secret,
-			},
-		})
+		rover := config.Rover
+		if len(args) > 0 {
+			rover = args[0]
+		}
+
+		response, err := client.Status(ctx, &roveapi.StatusRequest{Rover: rover})
 
 		switch {
 		case err != nil:
@@ -36,17 +36,47 @@ func Test_InnerMain(t *testing.T) {
 	// Register should fail without a name
 	assert.Error(t, InnerMain("register"))
 
+	// Register should fail with invalid or reserved names
+	assert.Error(t, InnerMain("register", "bad name"))
+	assert.Error(t, InnerMain("register", "admin"))
+
 	// These methods should fail without an account
 	assert.Error(t, InnerMain("radar"))
 	assert.Error(t, InnerMain("status"))
 
 	// Now set the name
-	assert.NoError(t, InnerMain("register", uuid.New().String()))
+	name := uuid.New().String()
+	assert.NoError(t, InnerMain("register", name))
 
 	// These should now work
 	assert.NoError(t, InnerMain("radar"))
 	assert.NoError(t, InnerMain("status"))
 
+	// The fleet should hold the registered rover
+	assert.NoError(t, InnerMain("fleet"))
+	assert.Error(t, InnerMain("fleet", "unknown"))
+	assert.Error(t, InnerMain("status", "unknown"))
+
+	// Teams
+	assert.NoError(t, InnerMain("map", "5"))
+	assert.Error(t, InnerMain("map", "0"))
+	assert.Error(t, InnerMain("team"))
+	assert.Error(t, InnerMain("team", "create"))
+	assert.Error(t, InnerMain("team", "message", "hi"))
+	assert.NoError(t, InnerMain("team", "create", uuid.New().String()))
+	assert.NoError(t, InnerMain("team", "message", "hello", "team"))
+	assert.NoError(t, InnerMain("team", "leave"))
+
+	// Spectating
+	assert.NoError(t, InnerMain("spectate", "20", "2"))
+	assert.Error(t, InnerMain("spectate", "far"))
+	assert.Error(t, InnerMain("visibility"))
+	assert.NoError(t, InnerMain("visibility", "public"))
+
+	// The rotated secret should be saved and used from now on
+	assert.NoError(t, InnerMain("rotate-secret"))
+	assert.NoError(t, InnerMain("status"))
+
 	// Commands should fail with no commands
 	assert.Error(t, InnerMain("command"))
 
@@ -54,14 +84,42 @@ func Test_InnerMain(t *testing.T) {
 	assert.NoError(t, InnerMain("command", "toggle"))
 	assert.NoError(t, InnerMain("command", "stash"))
 	assert.NoError(t, InnerMain("command", "repair"))
-	assert.NoError(t, InnerMain("command", "upgrade", "capacity"))
+	assert.NoError(t, InnerMain("command", "install", "HullPlate"))
+	assert.NoError(t, InnerMain("command", "uninstall", "hull"))
 	assert.NoError(t, InnerMain("command", "broadcast", "abc"))
+	assert.NoError(t, InnerMain("command", "drop", "0"))
+	assert.NoError(t, InnerMain("command", "drop", "RockSmall", "NE"))
+	assert.NoError(t, InnerMain("command", "craft", "hull-plate"))
+	assert.NoError(t, InnerMain("command", "build", "beacon", "hey", "N"))
+	assert.NoError(t, InnerMain("command", "build", "cache"))
+	assert.NoError(t, InnerMain("command", "deposit", "RockSmall", "S"))
+	assert.NoError(t, InnerMain("command", "withdraw"))
+	assert.NoError(t, InnerMain("command", "claim"))
 	assert.NoError(t, InnerMain("command", "wait", "10"))
 	assert.NoError(t, InnerMain("command", "wait", "1", "turn", "NW", "toggle", "broadcast", "zyx"))
 
 	// Give it malformed commands
 	assert.Error(t, InnerMain("command", "unknown"))
 	assert.Error(t, InnerMain("command", "broadcast"))
-	assert.Error(t, InnerMain("command", "upgrade"))
+	assert.Error(t, InnerMain("command", "broadcast", "far too long a message"))
+	assert.Error(t, InnerMain("command", "install"))
+	assert.Error(t, InnerMain("command", "uninstall"))
+	assert.Error(t, InnerMain("command", "uninstall", "unknown"))
+	assert.Error(t, InnerMain("command", "build"))
+	assert.Error(t, InnerMain("command", "build", "beacon"))
+	assert.Error(t, InnerMain("command", "build", "unknown"))
+	assert.Error(t, InnerMain("command", "deposit"))
+	assert.Error(t, InnerMain("command", "drop"))
+	assert.Error(t, InnerMain("command", "craft"))
+	assert.Error(t, InnerMain("command", "craft", "unknown"))
+	assert.Error(t, InnerMain("command", "drop", "unknown"))
 	assert.Error(t, InnerMain("command", "1"))
+
+	// Deleting the account needs the name to confirm
+	assert.Error(t, InnerMain("delete-account"))
+	assert.Error(t, InnerMain("delete-account", "wrong"))
+	assert.NoError(t, InnerMain("delete-account", name))
+
+	// The account is gone now
+	assert.Error(t, InnerMain("status"))
 }
@@ -1,9 +1,20 @@
 package accounts
 
+import "errors"
+
+var (
+	// ErrAccountNotFound is returned when no account matches the given name
+	ErrAccountNotFound = errors.New("no account found for id")
+
+	// ErrAccountExists is returned when registering an account name that is already taken
+	ErrAccountExists = errors.New("account name already registered")
+)
+
 // Accountant decribes something that stores accounts and account values
+// Implementations must be safe for concurrent use
 type Accountant interface {
-	// RegisterAccount will register a new account and return it's info
-	RegisterAccount(name string) (acc Account, err error)
+	// RegisterAccount will register a new account and return it's info along with the generated secret
+	RegisterAccount(name string) (acc Account, secret string, err error)
 
 	// AssignData stores a custom account key value pair
 	AssignData(account string, key string, value string) error
@@ -14,8 +25,14 @@ type Accountant interface {
 	// VerifySecret will verify whether the account secret matches with the
 	VerifySecret(account string, secret string) (bool, error)
 
-	// GetSecret gets the secret associated with an account
-	GetSecret(account string) (string, error)
+	// RotateSecret replaces the account secret and returns the new one
+	RotateSecret(account string) (string, error)
+
+	// DeleteAccount removes an account and all its data, freeing the name
+	DeleteAccount(account string) error
+
+	// ListAccounts returns the names of all registered accounts
+	ListAccounts() []string
 }
 
 // Account represents a registered user
@@ -26,3 +43,15 @@ type Account struct {
 	// Data represents internal account data
 	Data map[string]string
 }
+
+// copy returns a deep copy of the account
+func (a Account) copy() Account {
+	c := Account{
+		Name: a.Name,
+		Data: make(map[string]string, len(a.Data)),
+	}
+	for k, v := range a.Data {
+		c.Data[k] = v
+	}
+	return c
+}
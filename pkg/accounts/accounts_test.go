@@ -1,6 +1,10 @@
 package accounts
 
 import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
@@ -21,7 +25,7 @@ func TestAccountant_RegisterAccount(t *testing.T) {
 	// Start by making two accounts
 
 	namea := uuid.New().String()
-	acca, err := accountant.RegisterAccount(namea)
+	acca, _, err := accountant.RegisterAccount(namea)
 	if err != nil {
 		t.Error(err)
 	} else if acca.Name != namea {
@@ -29,7 +33,7 @@ func TestAccountant_RegisterAccount(t *testing.T) {
 	}
 
 	nameb := uuid.New().String()
-	accb, err := accountant.RegisterAccount(nameb)
+	accb, _, err := accountant.RegisterAccount(nameb)
 	if err != nil {
 		t.Error(err)
 	} else if accb.Name != nameb {
@@ -37,7 +41,7 @@ func TestAccountant_RegisterAccount(t *testing.T) {
 	}
 
 	// Verify another request gets rejected
-	_, err = accountant.RegisterAccount(namea)
+	_, _, err = accountant.RegisterAccount(namea)
 	if err == nil {
 		t.Error("Duplicate account name did not produce error")
 	}
@@ -47,7 +51,7 @@ func TestAccountant_AssignGetData(t *testing.T) {
 	accountant := NewSimpleAccountant()
 
 	name := uuid.New().String()
-	a, err := accountant.RegisterAccount(name)
+	a, _, err := accountant.RegisterAccount(name)
 	if err != nil {
 		t.Error(err)
 	}
@@ -61,3 +65,194 @@ func TestAccountant_AssignGetData(t *testing.T) {
 		t.Error("Fetched data is incorrect for account")
 	}
 }
+
+func TestAccountant_VerifyRotateSecret(t *testing.T) {
+	accountant := NewSimpleAccountant()
+
+	name := uuid.New().String()
+	a, secret, err := accountant.RegisterAccount(name)
+	if err != nil {
+		t.Error(err)
+	}
+
+	// The secret must not be stored in plaintext
+	for _, v := range a.Data {
+		if v == secret {
+			t.Error("Secret stored in plaintext")
+		}
+	}
+
+	if valid, err := accountant.VerifySecret(name, secret); err != nil {
+		t.Error(err)
+	} else if !valid {
+		t.Error("Failed to verify secret")
+	}
+
+	if valid, err := accountant.VerifySecret(name, "wrong"); err != nil {
+		t.Error(err)
+	} else if valid {
+		t.Error("Verified incorrect secret")
+	}
+
+	// Rotating should replace the secret
+	rotated, err := accountant.RotateSecret(name)
+	if err != nil {
+		t.Error(err)
+	} else if rotated == secret {
+		t.Error("Rotated secret matches the previous secret")
+	}
+
+	if valid, err := accountant.VerifySecret(name, secret); err != nil {
+		t.Error(err)
+	} else if valid {
+		t.Error("Verified previous secret after rotation")
+	}
+
+	if valid, err := accountant.VerifySecret(name, rotated); err != nil {
+		t.Error(err)
+	} else if !valid {
+		t.Error("Failed to verify rotated secret")
+	}
+}
+
+func TestAccountant_MigratePlaintextSecret(t *testing.T) {
+	accountant := NewSimpleAccountant()
+
+	b := []byte(`{"Accounts":{"old":{"Name":"old","Data":{"secret":"plain"}}}}`)
+	if err := json.Unmarshal(b, accountant); err != nil {
+		t.Error(err)
+	}
+
+	if valid, err := accountant.VerifySecret("old", "plain"); err != nil {
+		t.Error(err)
+	} else if !valid {
+		t.Error("Failed to verify migrated secret")
+	}
+
+	if value, err := accountant.GetValue("old", "secret"); err != nil {
+		t.Error(err)
+	} else if value != "" {
+		t.Error("Plaintext secret still stored after migration")
+	}
+}
+
+func TestAccountant_Concurrent(t *testing.T) {
+	accountant := NewSimpleAccountant()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			name := uuid.New().String()
+			_, secret, err := accountant.RegisterAccount(name)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			for j := 0; j < 16; j++ {
+				if valid, err := accountant.VerifySecret(name, secret); err != nil || !valid {
+					t.Error("Failed to verify secret")
+				}
+				if err := accountant.AssignData(name, "key", "value"); err != nil {
+					t.Error(err)
+				}
+				if _, err := accountant.GetValue(name, "key"); err != nil {
+					t.Error(err)
+				}
+				if _, err := json.Marshal(accountant); err != nil {
+					t.Error(err)
+				}
+			}
+
+			if secret, err = accountant.RotateSecret(name); err != nil {
+				t.Error(err)
+			} else if valid, err := accountant.VerifySecret(name, secret); err != nil || !valid {
+				t.Error("Failed to verify rotated secret")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Round trip the accounts to make sure they all survived
+	b, err := json.Marshal(accountant)
+	if err != nil {
+		t.Error(err)
+	}
+	loaded := NewSimpleAccountant()
+	if err := json.Unmarshal(b, loaded); err != nil {
+		t.Error(err)
+	} else if len(loaded.(*SimpleAccountant).Accounts) != 16 {
+		t.Errorf("Expected 16 accounts after load, got %d", len(loaded.(*SimpleAccountant).Accounts))
+	}
+}
+
+func TestAccountant_DeleteAccount(t *testing.T) {
+	accountant := NewSimpleAccountant()
+
+	name := uuid.New().String()
+	if _, _, err := accountant.RegisterAccount(name); err != nil {
+		t.Error(err)
+	}
+	if names := accountant.ListAccounts(); len(names) != 1 || names[0] != name {
+		t.Errorf("Unexpected account list: %v", names)
+	}
+
+	if err := accountant.DeleteAccount(name); err != nil {
+		t.Error(err)
+	} else if len(accountant.ListAccounts()) != 0 {
+		t.Error("Account still listed after delete")
+	}
+
+	// Deleting again should fail
+	if err := accountant.DeleteAccount(name); err == nil {
+		t.Error("Deleting a missing account did not produce error")
+	}
+
+	// And the name should be free again
+	if _, _, err := accountant.RegisterAccount(name); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	valid := []string{"abc", "Rover_01", "some-name", uuid.New().String()}
+	for _, name := range valid {
+		if err := ValidateName(name); err != nil {
+			t.Errorf("Valid name %q rejected: %s", name, err)
+		}
+	}
+
+	invalid := []string{"", "ab", "has space", "tab\tname", "bell\a", "ünïcode", "admin", "ROOT", strings.Repeat("a", 41)}
+	for _, name := range invalid {
+		if err := ValidateName(name); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Invalid name %q accepted", name)
+		}
+	}
+}
+
+func TestAccountant_CaseInsensitiveNames(t *testing.T) {
+	accountant := NewSimpleAccountant()
+
+	if _, _, err := accountant.RegisterAccount("Explorer"); err != nil {
+		t.Error(err)
+	}
+
+	if _, _, err := accountant.RegisterAccount("explorer"); !errors.Is(err, ErrAccountExists) {
+		t.Error("Name differing only in case did not produce error")
+	}
+
+	// The index should survive a round trip
+	b, err := json.Marshal(accountant)
+	if err != nil {
+		t.Error(err)
+	}
+	loaded := NewSimpleAccountant()
+	if err := json.Unmarshal(b, loaded); err != nil {
+		t.Error(err)
+	} else if _, _, err := loaded.RegisterAccount("EXPLORER"); !errors.Is(err, ErrAccountExists) {
+		t.Error("Loaded accountant accepted duplicate name")
+	}
+}
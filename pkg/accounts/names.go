@@ -0,0 +1,61 @@
+package accounts
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// minNameLength is the shortest allowed account name
+	minNameLength = 3
+
+	// maxNameLength is the longest allowed account name
+	maxNameLength = 40
+)
+
+// ErrInvalidName is returned when an account name doesn't meet the naming policy
+var ErrInvalidName = errors.New("invalid account name")
+
+// reservedNames are names that can't be registered by players, in lower case
+var reservedNames = map[string]bool{
+	"admin":         true,
+	"administrator": true,
+	"moderator":     true,
+	"root":          true,
+	"rove":          true,
+	"server":        true,
+	"system":        true,
+}
+
+// ValidateName checks an account name meets the naming policy
+// Names must be 3-40 characters of ASCII letters, digits, '-' or '_', and not reserved
+func ValidateName(name string) error {
+	if len(name) < minNameLength {
+		return fmt.Errorf("%w: must be at least %d characters", ErrInvalidName, minNameLength)
+	} else if len(name) > maxNameLength {
+		return fmt.Errorf("%w: must be at most %d characters", ErrInvalidName, maxNameLength)
+	}
+
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9':
+		case c == '-' || c == '_':
+		default:
+			return fmt.Errorf("%w: character %q not allowed, use letters, digits, '-' or '_'", ErrInvalidName, c)
+		}
+	}
+
+	if reservedNames[nameKey(name)] {
+		return fmt.Errorf("%w: %s is reserved", ErrInvalidName, name)
+	}
+
+	return nil
+}
+
+// nameKey returns the key used to compare names case insensitively
+func nameKey(name string) string {
+	return strings.ToLower(name)
+}
@@ -1,79 +1,167 @@
 package accounts
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const (
+	// secretHashKey is the account data key for the hashed secret
+	secretHashKey = "secret-hash"
+
+	// secretSaltKey is the account data key for the secret salt
+	secretSaltKey = "secret-salt"
+
+	// plainSecretKey is the account data key previously used to store plaintext secrets
+	plainSecretKey = "secret"
+
+	// saltLength is the number of random bytes used to salt a secret
+	saltLength = 16
+)
+
 // SimpleAccountant manages a set of accounts
+// It is safe for concurrent use
 type SimpleAccountant struct {
 	Accounts map[string]Account
+
+	// names maps case insensitive name keys to account names
+	names map[string]string
+
+	// mutex guards the accounts and all account data
+	mutex sync.RWMutex
 }
 
 // NewSimpleAccountant creates a new accountant
 func NewSimpleAccountant() Accountant {
 	return &SimpleAccountant{
 		Accounts: make(map[string]Account),
+		names:    make(map[string]string),
 	}
 }
 
 // RegisterAccount adds an account to the set of internal accounts
-func (a *SimpleAccountant) RegisterAccount(name string) (acc Account, err error) {
+func (a *SimpleAccountant) RegisterAccount(name string) (acc Account, secret string, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if err := ValidateName(name); err != nil {
+		return Account{}, "", err
+	}
+
+	// Verify this acount isn't already registered, ignoring case
+	if existing, ok := a.names[nameKey(name)]; ok {
+		return Account{}, "", fmt.Errorf("%w: %s", ErrAccountExists, existing)
+	}
 
 	// Set up the account info
 	acc.Name = name
 	acc.Data = make(map[string]string)
 
-	// Verify this acount isn't already registered
-	for _, a := range a.Accounts {
-		if a.Name == acc.Name {
-			return Account{}, fmt.Errorf("account name already registered: %s", a.Name)
-		}
-	}
-
 	// Set the creation time
 	acc.Data["created"] = time.Now().String()
 
-	// Create a secret
-	acc.Data["secret"] = uuid.New().String()
+	// Create a secret, only the hash is stored
+	secret = uuid.New().String()
+	if err := setSecret(acc, secret); err != nil {
+		return Account{}, "", err
+	}
 
-	// Simply add the account to the map
+	// Simply add the account to the maps
 	a.Accounts[acc.Name] = acc
+	a.names[nameKey(acc.Name)] = acc.Name
+
+	// Hand back a copy so the caller can't race with later changes
+	acc = acc.copy()
 
 	return
 }
 
 // VerifySecret verifies if an account secret is correct
 func (a *SimpleAccountant) VerifySecret(account string, secret string) (bool, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
 	// Find the account matching the ID
-	if this, ok := a.Accounts[account]; ok {
-		return this.Data["secret"] == secret, nil
+	this, ok := a.Accounts[account]
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrAccountNotFound, account)
+	}
+
+	salt, err := hex.DecodeString(this.Data[secretSaltKey])
+	if err != nil {
+		return false, fmt.Errorf("invalid secret salt for account %s: %s", account, err)
+	}
+	hash, err := hex.DecodeString(this.Data[secretHashKey])
+	if err != nil {
+		return false, fmt.Errorf("invalid secret hash for account %s: %s", account, err)
 	}
 
-	return false, fmt.Errorf("no account found for id: %s", account)
+	return subtle.ConstantTimeCompare(hashSecret(salt, secret), hash) == 1, nil
 }
 
-// GetSecret gets the internal secret
-func (a *SimpleAccountant) GetSecret(account string) (string, error) {
+// RotateSecret replaces the account secret with a newly generated one
+func (a *SimpleAccountant) RotateSecret(account string) (string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
 	// Find the account matching the ID
-	if this, ok := a.Accounts[account]; ok {
-		return this.Data["secret"], nil
+	this, ok := a.Accounts[account]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrAccountNotFound, account)
+	}
+
+	secret := uuid.New().String()
+	if err := setSecret(this, secret); err != nil {
+		return "", err
 	}
 
-	return "", fmt.Errorf("no account found for id: %s", account)
+	return secret, nil
+}
+
+// DeleteAccount removes an account
+func (a *SimpleAccountant) DeleteAccount(account string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if _, ok := a.Accounts[account]; !ok {
+		return fmt.Errorf("%w: %s", ErrAccountNotFound, account)
+	}
+	delete(a.Accounts, account)
+	delete(a.names, nameKey(account))
+
+	return nil
+}
+
+// ListAccounts returns the names of all accounts
+func (a *SimpleAccountant) ListAccounts() (names []string) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	for name := range a.Accounts {
+		names = append(names, name)
+	}
+	return
 }
 
 // AssignData assigns data to an account
 func (a *SimpleAccountant) AssignData(account string, key string, value string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
 
 	// Find the account matching the ID
 	if this, ok := a.Accounts[account]; ok {
 		this.Data[key] = value
 		a.Accounts[account] = this
 	} else {
-		return fmt.Errorf("no account found for id: %s", account)
+		return fmt.Errorf("%w: %s", ErrAccountNotFound, account)
 	}
 
 	return nil
@@ -81,10 +169,78 @@ func (a *SimpleAccountant) AssignData(account string, key string, value string)
 
 // GetValue gets the rover rover for the account
 func (a *SimpleAccountant) GetValue(account string, key string) (string, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
 	// Find the account matching the ID
 	this, ok := a.Accounts[account]
 	if !ok {
-		return "", fmt.Errorf("no account found for id: %s", account)
+		return "", fmt.Errorf("%w: %s", ErrAccountNotFound, account)
 	}
 	return this.Data[key], nil
 }
+
+// MarshalJSON saves the accounts
+func (a *SimpleAccountant) MarshalJSON() ([]byte, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return json.Marshal(struct {
+		Accounts map[string]Account
+	}{a.Accounts})
+}
+
+// UnmarshalJSON loads the accounts, hashing any secrets still stored in plaintext
+func (a *SimpleAccountant) UnmarshalJSON(b []byte) error {
+	var data struct {
+		Accounts map[string]Account
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.Accounts = data.Accounts
+	if a.Accounts == nil {
+		a.Accounts = make(map[string]Account)
+	}
+
+	// Rebuild the name index
+	a.names = make(map[string]string, len(a.Accounts))
+	for name := range a.Accounts {
+		a.names[nameKey(name)] = name
+	}
+
+	for _, acc := range a.Accounts {
+		if secret, ok := acc.Data[plainSecretKey]; ok {
+			if err := setSecret(acc, secret); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// setSecret stores a freshly salted hash of the secret in the account data
+func setSecret(acc Account, secret string) error {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate secret salt: %s", err)
+	}
+
+	acc.Data[secretSaltKey] = hex.EncodeToString(salt)
+	acc.Data[secretHashKey] = hex.EncodeToString(hashSecret(salt, secret))
+	delete(acc.Data, plainSecretKey)
+	return nil
+}
+
+// hashSecret hashes a secret with the given salt
+func hashSecret(salt []byte, secret string) []byte {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(secret))
+	return h.Sum(nil)
+}
@@ -0,0 +1,172 @@
+package accounts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrTeamNotFound is returned when no team matches the given name
+	ErrTeamNotFound = errors.New("no team found for name")
+
+	// ErrTeamExists is returned when creating a team name that is already taken
+	ErrTeamExists = errors.New("team name already registered")
+
+	// ErrAlreadyInTeam is returned when an account in a team tries to create or join another
+	ErrAlreadyInTeam = errors.New("account already in a team")
+
+	// ErrNotInTeam is returned when an account not in a team tries to use one
+	ErrNotInTeam = errors.New("account not in a team")
+)
+
+// TeamRegistry tracks which accounts belong to which team
+// It is safe for concurrent use
+type TeamRegistry struct {
+	// Members is a team->accounts map of all team members
+	Members map[string][]string
+
+	// teams maps accounts to their team
+	teams map[string]string
+
+	// names maps case insensitive name keys to team names
+	names map[string]string
+
+	// mutex guards all team data
+	mutex sync.RWMutex
+}
+
+// NewTeamRegistry creates an empty team registry
+func NewTeamRegistry() *TeamRegistry {
+	return &TeamRegistry{
+		Members: make(map[string][]string),
+		teams:   make(map[string]string),
+		names:   make(map[string]string),
+	}
+}
+
+// CreateTeam creates a new team with the account as its first member
+func (t *TeamRegistry) CreateTeam(team string, account string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if err := ValidateName(team); err != nil {
+		return err
+	} else if existing, ok := t.names[nameKey(team)]; ok {
+		return fmt.Errorf("%w: %s", ErrTeamExists, existing)
+	} else if current, ok := t.teams[account]; ok {
+		return fmt.Errorf("%w: %s", ErrAlreadyInTeam, current)
+	}
+
+	t.Members[team] = []string{account}
+	t.teams[account] = team
+	t.names[nameKey(team)] = team
+	return nil
+}
+
+// JoinTeam adds the account to an existing team
+func (t *TeamRegistry) JoinTeam(team string, account string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	name, ok := t.names[nameKey(team)]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrTeamNotFound, team)
+	} else if current, ok := t.teams[account]; ok {
+		return fmt.Errorf("%w: %s", ErrAlreadyInTeam, current)
+	}
+
+	t.Members[name] = append(t.Members[name], account)
+	t.teams[account] = name
+	return nil
+}
+
+// LeaveTeam removes the account from its team, the team is removed once empty
+func (t *TeamRegistry) LeaveTeam(account string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	team, ok := t.teams[account]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotInTeam, account)
+	}
+
+	var remaining []string
+	for _, m := range t.Members[team] {
+		if m != account {
+			remaining = append(remaining, m)
+		}
+	}
+	delete(t.teams, account)
+
+	if len(remaining) == 0 {
+		delete(t.Members, team)
+		delete(t.names, nameKey(team))
+	} else {
+		t.Members[team] = remaining
+	}
+	return nil
+}
+
+// TeamOf returns the team for an account, or an empty string if it has none
+func (t *TeamRegistry) TeamOf(account string) string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.teams[account]
+}
+
+// Teammates returns the sorted members of the account's team, or just the account if it has no team
+func (t *TeamRegistry) Teammates(account string) []string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	team, ok := t.teams[account]
+	if !ok {
+		return []string{account}
+	}
+
+	members := append([]string{}, t.Members[team]...)
+	sort.Strings(members)
+	return members
+}
+
+// MarshalJSON saves the teams
+func (t *TeamRegistry) MarshalJSON() ([]byte, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return json.Marshal(struct {
+		Members map[string][]string
+	}{t.Members})
+}
+
+// UnmarshalJSON loads the teams and rebuilds the lookups
+func (t *TeamRegistry) UnmarshalJSON(b []byte) error {
+	var data struct {
+		Members map[string][]string
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.Members = data.Members
+	if t.Members == nil {
+		t.Members = make(map[string][]string)
+	}
+
+	t.teams = make(map[string]string)
+	t.names = make(map[string]string, len(t.Members))
+	for team, members := range t.Members {
+		t.names[nameKey(team)] = team
+		for _, m := range members {
+			t.teams[m] = team
+		}
+	}
+	return nil
+}
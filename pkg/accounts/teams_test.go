@@ -0,0 +1,66 @@
+package accounts
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestTeamRegistry_CreateJoinLeave(t *testing.T) {
+	teams := NewTeamRegistry()
+
+	if err := teams.CreateTeam("explorers", "a"); err != nil {
+		t.Error(err)
+	}
+	if err := teams.CreateTeam("Explorers", "b"); !errors.Is(err, ErrTeamExists) {
+		t.Error("Duplicate team name did not produce error")
+	}
+	if err := teams.CreateTeam("bad name", "b"); !errors.Is(err, ErrInvalidName) {
+		t.Error("Invalid team name did not produce error")
+	}
+	if err := teams.CreateTeam("others", "a"); !errors.Is(err, ErrAlreadyInTeam) {
+		t.Error("Creating a second team did not produce error")
+	}
+
+	if err := teams.JoinTeam("unknown", "b"); !errors.Is(err, ErrTeamNotFound) {
+		t.Error("Joining unknown team did not produce error")
+	}
+	if err := teams.JoinTeam("EXPLORERS", "b"); err != nil {
+		t.Error(err)
+	}
+	if team := teams.TeamOf("b"); team != "explorers" {
+		t.Errorf("Unexpected team: %s", team)
+	}
+	if mates := teams.Teammates("b"); len(mates) != 2 {
+		t.Errorf("Unexpected teammates: %v", mates)
+	}
+	if mates := teams.Teammates("c"); len(mates) != 1 || mates[0] != "c" {
+		t.Errorf("Unexpected teammates for account without team: %v", mates)
+	}
+
+	// The lookups should survive a round trip
+	b, err := json.Marshal(teams)
+	if err != nil {
+		t.Error(err)
+	}
+	loaded := NewTeamRegistry()
+	if err := json.Unmarshal(b, loaded); err != nil {
+		t.Error(err)
+	} else if team := loaded.TeamOf("a"); team != "explorers" {
+		t.Errorf("Unexpected team after load: %s", team)
+	}
+
+	// The team goes away once everyone has left
+	if err := teams.LeaveTeam("c"); !errors.Is(err, ErrNotInTeam) {
+		t.Error("Leaving without a team did not produce error")
+	}
+	if err := teams.LeaveTeam("a"); err != nil {
+		t.Error(err)
+	}
+	if err := teams.LeaveTeam("b"); err != nil {
+		t.Error(err)
+	}
+	if err := teams.JoinTeam("explorers", "c"); !errors.Is(err, ErrTeamNotFound) {
+		t.Error("Empty team was not removed")
+	}
+}
@@ -0,0 +1,192 @@
+package certs
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"time"
+)
+
+// certificateLifetime is how long generated certificates are valid for
+const certificateLifetime = 365 * 24 * time.Hour
+
+// LocalHosts are the host names a self-signed certificate for local play covers
+var LocalHosts = []string{"localhost", "127.0.0.1", "::1"}
+
+// newCertificate creates a PEM encoded certificate and new private key from a template, signed by the parent
+// A nil parent self-signs the certificate
+func newCertificate(template *x509.Certificate, parent *x509.Certificate, parentKey crypto.Signer) (certPEM []byte, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if template.SerialNumber, err = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128)); err != nil {
+		return nil, nil, err
+	}
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
+
+// GenerateSelfSigned creates a PEM encoded self-signed certificate and private key for the given hosts
+func GenerateSelfSigned(hosts []string) (certPEM []byte, keyPEM []byte, err error) {
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject:               pkix.Name{Organization: []string{"Rove"}, CommonName: "rove-server"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certificateLifetime),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	return newCertificate(template, nil, nil)
+}
+
+// GenerateCA creates a PEM encoded certificate authority and private key for issuing client certificates
+func GenerateCA() (certPEM []byte, keyPEM []byte, err error) {
+	now := time.Now()
+	return newCertificate(&x509.Certificate{
+		Subject:               pkix.Name{Organization: []string{"Rove"}, CommonName: "rove-client-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certificateLifetime),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil, nil)
+}
+
+// IssueClientCert creates a PEM encoded client certificate and private key for an account, signed by a CA
+// The account is the certificate common name, which the server maps back to the account
+func IssueClientCert(caCertPEM []byte, caKeyPEM []byte, account string) (certPEM []byte, keyPEM []byte, err error) {
+	ca, err := tls.X509KeyPair(caCertPEM, caKeyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	caCert, err := x509.ParseCertificate(ca.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	caKey, ok := ca.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, fmt.Errorf("CA key can't sign certificates")
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject:     pkix.Name{Organization: []string{"Rove"}, CommonName: account},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.Add(certificateLifetime),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	return newCertificate(template, caCert, caKey)
+}
+
+// EnsureSelfSigned generates a self-signed certificate and key into the given files, unless the certificate already exists
+// The certificate file doubles as the CA bundle clients need to trust the server
+func EnsureSelfSigned(certFile string, keyFile string, hosts []string) error {
+	if _, err := os.Stat(certFile); err == nil {
+		return nil
+	}
+
+	certPEM, keyPEM, err := GenerateSelfSigned(hosts)
+	if err != nil {
+		return fmt.Errorf("failed to generate self-signed certificate: %s", err)
+	} else if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(certFile, certPEM, 0644)
+}
+
+// loadPool loads a pool of CA certificates from a PEM bundle
+func loadPool(caFile string) (*x509.CertPool, error) {
+	b, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in CA bundle %s", caFile)
+	}
+	return pool, nil
+}
+
+// ServerConfig creates a server TLS config from a certificate and key file
+// Client certificates are verified against a CA bundle when one is given, and required if requireClientCert is set
+func ServerConfig(certFile string, keyFile string, clientCAFile string, requireClientCert bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if len(clientCAFile) > 0 {
+		if config.ClientCAs, err = loadPool(clientCAFile); err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+		if requireClientCert {
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	} else if requireClientCert {
+		return nil, fmt.Errorf("requiring client certificates needs a client CA bundle")
+	}
+	return config, nil
+}
+
+// ClientConfig creates a client TLS config
+// The server is verified against a CA bundle if one is given, or the system roots otherwise, and a client certificate is
+// presented if a certificate and key file are given
+func ClientConfig(caFile string, certFile string, keyFile string) (*tls.Config, error) {
+	config := &tls.Config{}
+
+	if len(caFile) > 0 {
+		var err error
+		if config.RootCAs, err = loadPool(caFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(certFile) > 0 || len(keyFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
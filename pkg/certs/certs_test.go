@@ -0,0 +1,117 @@
+package certs
+
+import (
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// handshake runs a TLS handshake between two configs and returns the state the server saw
+func handshake(t *testing.T, server *tls.Config, client *tls.Config) (tls.ConnectionState, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	errs := make(chan error, 1)
+	go func() {
+		conn, err := tls.Dial("tcp", listener.Addr().String(), client)
+		if err == nil {
+			// Read until the server closes so the server sees the whole handshake
+			_, err = conn.Read(make([]byte, 1))
+			conn.Close()
+		}
+		errs <- err
+	}()
+
+	conn, err := listener.Accept()
+	assert.NoError(t, err)
+	tlsConn := conn.(*tls.Conn)
+	err = tlsConn.Handshake()
+	state := tlsConn.ConnectionState()
+	conn.Close()
+
+	if clientErr := <-errs; err == nil && clientErr != io.EOF {
+		err = clientErr
+	}
+	return state, err
+}
+
+func TestCerts_SelfSigned(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-certs-")
+	assert.NoError(t, err)
+	certFile := path.Join(tmp, "cert.pem")
+	keyFile := path.Join(tmp, "key.pem")
+
+	assert.NoError(t, EnsureSelfSigned(certFile, keyFile, LocalHosts))
+	first, err := ioutil.ReadFile(certFile)
+	assert.NoError(t, err)
+
+	// An existing certificate is kept
+	assert.NoError(t, EnsureSelfSigned(certFile, keyFile, LocalHosts))
+	second, err := ioutil.ReadFile(certFile)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	server, err := ServerConfig(certFile, keyFile, "", false)
+	assert.NoError(t, err)
+
+	// Clients trust the server with the certificate as their CA bundle
+	client, err := ClientConfig(certFile, "", "")
+	assert.NoError(t, err)
+	client.ServerName = "localhost"
+	_, err = handshake(t, server, client)
+	assert.NoError(t, err)
+
+	// But not with the system roots
+	client, err = ClientConfig("", "", "")
+	assert.NoError(t, err)
+	client.ServerName = "localhost"
+	_, err = handshake(t, server, client)
+	assert.Error(t, err)
+
+	_, err = ServerConfig(certFile, keyFile, "", true)
+	assert.Error(t, err)
+	_, err = ClientConfig(keyFile, "", "")
+	assert.Error(t, err)
+}
+
+func TestCerts_ClientCert(t *testing.T) {
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-certs-")
+	assert.NoError(t, err)
+	write := func(name string, b []byte) string {
+		file := path.Join(tmp, name)
+		assert.NoError(t, ioutil.WriteFile(file, b, 0600))
+		return file
+	}
+
+	serverCert, serverKey, err := GenerateSelfSigned(LocalHosts)
+	assert.NoError(t, err)
+	caCert, caKey, err := GenerateCA()
+	assert.NoError(t, err)
+	clientCert, clientKey, err := IssueClientCert(caCert, caKey, "my-account")
+	assert.NoError(t, err)
+
+	server, err := ServerConfig(write("server.pem", serverCert), write("server-key.pem", serverKey), write("ca.pem", caCert), true)
+	assert.NoError(t, err)
+
+	// The client certificate is verified and carries the account
+	client, err := ClientConfig(path.Join(tmp, "server.pem"), write("client.pem", clientCert), write("client-key.pem", clientKey))
+	assert.NoError(t, err)
+	client.ServerName = "localhost"
+	state, err := handshake(t, server, client)
+	assert.NoError(t, err)
+	assert.Len(t, state.VerifiedChains, 1)
+	assert.Equal(t, "my-account", state.VerifiedChains[0][0].Subject.CommonName)
+
+	// Clients without a certificate are rejected when one is required
+	client, err = ClientConfig(path.Join(tmp, "server.pem"), "", "")
+	assert.NoError(t, err)
+	client.ServerName = "localhost"
+	_, err = handshake(t, server, client)
+	assert.Error(t, err)
+}
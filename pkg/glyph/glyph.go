@@ -0,0 +1,133 @@
+package glyph
+
+import (
+	"log"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+)
+
+// Glyph represents the text representation of something in the game
+type Glyph byte
+
+const (
+	// GlyphGroundRock is solid rock ground
+	GlyphGroundRock = Glyph('-')
+
+	// GlyphGroundGravel is loose rocks
+	GlyphGroundGravel = Glyph(':')
+
+	// GlyphGroundSand is sand
+	GlyphGroundSand = Glyph('~')
+
+	// GlyphRoverLive represents a live rover
+	GlyphRoverLive = Glyph('R')
+
+	// GlyphRoverDormant represents a dormant rover
+	GlyphRoverDormant = Glyph('r')
+
+	// GlyphRoverParts represents spare rover parts
+	GlyphRoverParts = Glyph('*')
+
+	// GlyphRockSmall is a small stashable rock
+	GlyphRockSmall = Glyph('o')
+
+	// GlyphRockLarge is a large blocking rock
+	GlyphRockLarge = Glyph('O')
+
+	// GlyphSolarPanel is a crafted solar panel
+	GlyphSolarPanel = Glyph('#')
+
+	// GlyphAntennaBooster is a crafted antenna booster
+	GlyphAntennaBooster = Glyph('^')
+
+	// GlyphHullPlate is a crafted hull plate
+	GlyphHullPlate = Glyph('=')
+
+	// GlyphBatteryCell is a crafted battery cell
+	GlyphBatteryCell = Glyph('+')
+
+	// GlyphDrillBit is a crafted drill bit
+	GlyphDrillBit = Glyph('v')
+
+	// GlyphBeacon is a beacon structure
+	GlyphBeacon = Glyph('B')
+
+	// GlyphSolarStation is a solar station structure
+	GlyphSolarStation = Glyph('S')
+
+	// GlyphCache is a cache structure
+	GlyphCache = Glyph('C')
+)
+
+// TileGlyph returns the glyph for this tile type
+func TileGlyph(t roveapi.Tile) Glyph {
+	switch t {
+	case roveapi.Tile_Rock:
+		return GlyphGroundRock
+	case roveapi.Tile_Gravel:
+		return GlyphGroundGravel
+	case roveapi.Tile_Sand:
+		return GlyphGroundSand
+	}
+
+	log.Fatalf("Unknown tile type: %c", t)
+	return 0
+}
+
+// ObjectGlyph returns the glyph for this object type
+func ObjectGlyph(o roveapi.Object) Glyph {
+	switch o {
+	case roveapi.Object_RoverLive:
+		return GlyphRoverLive
+	case roveapi.Object_RockSmall:
+		return GlyphRockSmall
+	case roveapi.Object_RoverDormant:
+		return GlyphRoverDormant
+	case roveapi.Object_RockLarge:
+		return GlyphRockLarge
+	case roveapi.Object_RoverParts:
+		return GlyphRoverParts
+	case roveapi.Object_SolarPanel:
+		return GlyphSolarPanel
+	case roveapi.Object_AntennaBooster:
+		return GlyphAntennaBooster
+	case roveapi.Object_HullPlate:
+		return GlyphHullPlate
+	case roveapi.Object_BatteryCell:
+		return GlyphBatteryCell
+	case roveapi.Object_DrillBit:
+		return GlyphDrillBit
+	case roveapi.Object_Beacon:
+		return GlyphBeacon
+	case roveapi.Object_SolarStation:
+		return GlyphSolarStation
+	case roveapi.Object_Cache:
+		return GlyphCache
+	}
+
+	log.Fatalf("Unknown object type: %c", o)
+	return 0
+}
+
+// BearingFromString converts a compass abbreviation, such as N or SW, to a bearing
+func BearingFromString(s string) roveapi.Bearing {
+	switch s {
+	case "N":
+		return roveapi.Bearing_North
+	case "NE":
+		return roveapi.Bearing_NorthEast
+	case "E":
+		return roveapi.Bearing_East
+	case "SE":
+		return roveapi.Bearing_SouthEast
+	case "S":
+		return roveapi.Bearing_South
+	case "SW":
+		return roveapi.Bearing_SouthWest
+	case "W":
+		return roveapi.Bearing_West
+	case "NW":
+		return roveapi.Bearing_NorthWest
+	}
+	return roveapi.Bearing_BearingUnknown
+}
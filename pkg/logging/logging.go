@@ -0,0 +1,250 @@
+// Package logging provides levelled, structured logging split into named subsystems
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a log entry
+type Level int
+
+const (
+	// LevelDebug is for detail only useful when investigating a problem
+	LevelDebug Level = iota
+	// LevelInfo is for the normal running of the server
+	LevelInfo
+	// LevelWarn is for problems that were recovered from
+	LevelWarn
+	// LevelError is for failures that need attention
+	LevelError
+)
+
+var levelNames = []string{"debug", "info", "warn", "error"}
+
+// String returns the name of the level
+func (l Level) String() string {
+	if l < LevelDebug || l > LevelError {
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel parses a level from its name
+func ParseLevel(s string) (Level, error) {
+	for i, n := range levelNames {
+		if strings.EqualFold(s, n) {
+			return Level(i), nil
+		}
+	}
+	return LevelInfo, fmt.Errorf("unknown log level %q, expected one of %s", s, strings.Join(levelNames, ", "))
+}
+
+// Format is how log entries are written out
+type Format int
+
+const (
+	// FormatText writes entries as human readable lines
+	FormatText Format = iota
+	// FormatJSON writes entries as one JSON object per line
+	FormatJSON
+)
+
+// ParseFormat parses a format from its name
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "text":
+		return FormatText, nil
+	case "json":
+		return FormatJSON, nil
+	}
+	return FormatText, fmt.Errorf("unknown log format %q, expected text or json", s)
+}
+
+// Config describes how and what to log
+type Config struct {
+	// Level is the minimum level logged by subsystems without their own
+	Level Level
+
+	// Format is how entries are written
+	Format Format
+
+	// Subsystems overrides the minimum level for individual subsystems
+	Subsystems map[string]Level
+}
+
+// sink is shared by every logger created from the same root
+type sink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	config Config
+}
+
+// Logger writes structured entries for a subsystem
+// A nil Logger discards everything
+type Logger struct {
+	sink      *sink
+	subsystem string
+	level     Level
+	fields    []interface{}
+}
+
+// New creates a root logger writing to w
+func New(w io.Writer, config Config) *Logger {
+	return &Logger{
+		sink:  &sink{w: w, config: config},
+		level: config.Level,
+	}
+}
+
+// Default creates a root logger writing text to stderr at info level
+func Default() *Logger {
+	return New(os.Stderr, Config{Level: LevelInfo})
+}
+
+// Named returns a logger for a subsystem, using its configured level
+func (l *Logger) Named(subsystem string) *Logger {
+	if l == nil {
+		return nil
+	}
+
+	n := *l
+	n.subsystem = subsystem
+	n.level = l.sink.config.Level
+	if level, ok := l.sink.config.Subsystems[subsystem]; ok {
+		n.level = level
+	}
+	return &n
+}
+
+// With returns a logger that adds key/value pairs to every entry
+func (l *Logger) With(kv ...interface{}) *Logger {
+	if l == nil {
+		return nil
+	}
+
+	n := *l
+	n.fields = append(append([]interface{}{}, l.fields...), kv...)
+	return &n
+}
+
+// Enabled returns whether entries at a level will be written
+func (l *Logger) Enabled(level Level) bool {
+	return l != nil && level >= l.level
+}
+
+// Debug logs a message with key/value pairs at debug level
+func (l *Logger) Debug(msg string, kv ...interface{}) {
+	l.log(LevelDebug, msg, kv)
+}
+
+// Info logs a message with key/value pairs at info level
+func (l *Logger) Info(msg string, kv ...interface{}) {
+	l.log(LevelInfo, msg, kv)
+}
+
+// Warn logs a message with key/value pairs at warn level
+func (l *Logger) Warn(msg string, kv ...interface{}) {
+	l.log(LevelWarn, msg, kv)
+}
+
+// Error logs a message with key/value pairs at error level
+func (l *Logger) Error(msg string, kv ...interface{}) {
+	l.log(LevelError, msg, kv)
+}
+
+// Fatal logs a message at error level regardless of the configured level, then exits
+func (l *Logger) Fatal(msg string, kv ...interface{}) {
+	if l == nil {
+		l = Default()
+	}
+	l.write(LevelError, msg, kv)
+	os.Exit(1)
+}
+
+// log writes an entry if the level is enabled
+func (l *Logger) log(level Level, msg string, kv []interface{}) {
+	if l.Enabled(level) {
+		l.write(level, msg, kv)
+	}
+}
+
+// write formats and writes out a single entry
+func (l *Logger) write(level Level, msg string, kv []interface{}) {
+	fields := append(append([]interface{}{}, l.fields...), kv...)
+	if len(fields)%2 != 0 {
+		fields = append(fields[:len(fields)-1], "!BADKEY", fields[len(fields)-1])
+	}
+
+	var b bytes.Buffer
+	now := time.Now().UTC().Format(time.RFC3339)
+	if l.sink.config.Format == FormatJSON {
+		pairs := []interface{}{"time", now, "level", level.String()}
+		if l.subsystem != "" {
+			pairs = append(pairs, "subsystem", l.subsystem)
+		}
+		pairs = append(pairs, "msg", msg)
+
+		b.WriteByte('{')
+		for i, kv := 0, append(pairs, fields...); i < len(kv); i += 2 {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeJSON(&b, fmt.Sprint(kv[i]), value(kv[i+1]))
+		}
+		b.WriteString("}\n")
+	} else {
+		fmt.Fprintf(&b, "%s %-5s ", now, strings.ToUpper(level.String()))
+		if l.subsystem != "" {
+			fmt.Fprintf(&b, "%s: ", l.subsystem)
+		}
+		b.WriteString(msg)
+		for i := 0; i < len(fields); i += 2 {
+			fmt.Fprintf(&b, " %s=%s", fields[i], quote(fmt.Sprint(value(fields[i+1]))))
+		}
+		b.WriteByte('\n')
+	}
+
+	l.sink.mu.Lock()
+	defer l.sink.mu.Unlock()
+	l.sink.w.Write(b.Bytes())
+}
+
+// writeJSON writes a "key":value pair, falling back to the formatted value if it can't be marshalled
+func writeJSON(b *bytes.Buffer, key string, v interface{}) {
+	k, _ := json.Marshal(key)
+	b.Write(k)
+	b.WriteByte(':')
+	if j, err := json.Marshal(v); err == nil {
+		b.Write(j)
+	} else {
+		j, _ = json.Marshal(fmt.Sprint(v))
+		b.Write(j)
+	}
+}
+
+// value converts errors and stringers to their text so they're readable in both formats
+func value(v interface{}) interface{} {
+	switch t := v.(type) {
+	case error:
+		return t.Error()
+	case fmt.Stringer:
+		return t.String()
+	}
+	return v
+}
+
+// quote quotes text values that would otherwise be ambiguous in a text entry
+func quote(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevel(t *testing.T) {
+	for _, l := range []Level{LevelDebug, LevelInfo, LevelWarn, LevelError} {
+		parsed, err := ParseLevel(l.String())
+		assert.NoError(t, err)
+		assert.Equal(t, l, parsed)
+	}
+
+	parsed, err := ParseLevel("WARN")
+	assert.NoError(t, err)
+	assert.Equal(t, LevelWarn, parsed)
+
+	_, err = ParseLevel("loud")
+	assert.Error(t, err)
+
+	_, err = ParseFormat("xml")
+	assert.Error(t, err)
+}
+
+func TestLogger_Text(t *testing.T) {
+	var b bytes.Buffer
+	l := New(&b, Config{Level: LevelInfo}).Named("world").With("rover", "abc")
+
+	l.Debug("hidden")
+	assert.Empty(t, b.String())
+
+	l.Info("Moved rover", "to", "1,2", "reason", "wind blew", "err", errors.New("blocked"))
+	line := b.String()
+	assert.Contains(t, line, "INFO  world: Moved rover rover=abc to=1,2 reason=\"wind blew\" err=blocked\n")
+}
+
+func TestLogger_JSON(t *testing.T) {
+	var b bytes.Buffer
+	l := New(&b, Config{Level: LevelDebug, Format: FormatJSON}).Named("server")
+
+	l.Warn("Tick failed", "tick", 3, "odd")
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &entry))
+	assert.Equal(t, "warn", entry["level"])
+	assert.Equal(t, "server", entry["subsystem"])
+	assert.Equal(t, "Tick failed", entry["msg"])
+	assert.Equal(t, 3.0, entry["tick"])
+	assert.Equal(t, "odd", entry["!BADKEY"])
+	assert.NotEmpty(t, entry["time"])
+}
+
+func TestLogger_Subsystems(t *testing.T) {
+	var b bytes.Buffer
+	root := New(&b, Config{
+		Level:      LevelWarn,
+		Subsystems: map[string]Level{"rpc": LevelDebug, "world": LevelError},
+	})
+
+	root.Named("rpc").Debug("rpc debug")
+	root.Named("world").Warn("world warn")
+	root.Named("server").Info("server info")
+	root.Named("server").Warn("server warn")
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "rpc debug")
+	assert.Contains(t, lines[1], "server warn")
+
+	// A nil logger discards everything
+	var nilLogger *Logger
+	nilLogger.Named("any").With("k", "v").Error("dropped")
+	assert.False(t, nilLogger.Enabled(LevelError))
+}
@@ -4,14 +4,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"os"
 	"path"
+
+	"github.com/mdiluz/rove/pkg/logging"
 )
 
 // dataPath global path for persistence
 var dataPath = os.TempDir()
 
+// logger records saves and loads
+var logger = logging.Default().Named("persistence")
+
+// SetLogger sets the logger for saves and loads
+func SetLogger(l *logging.Logger) {
+	logger = l
+}
+
 // SetPath sets the persistent path for the data storage
 func SetPath(p string) error {
 	if info, err := os.Stat(p); err != nil {
@@ -36,11 +45,28 @@ func Save(name string, data interface{}) error {
 		return err
 	}
 
-	if err := ioutil.WriteFile(p, b, os.ModePerm); err != nil {
+	// Write to a temporary file and move it into place, so a save cut short never leaves a partial file
+	tmp, err := ioutil.TempFile(dataPath, fmt.Sprintf("rove-%s-*.tmp", name))
+	if err != nil {
 		return err
 	}
+	defer os.Remove(tmp.Name())
 
-	log.Printf("Saved %s\n", p)
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	} else if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	} else if err := tmp.Close(); err != nil {
+		return err
+	} else if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	} else if err := os.Rename(tmp.Name(), p); err != nil {
+		return err
+	}
+
+	logger.Debug("Saved", "file", p, "bytes", len(b))
 	return nil
 }
 
@@ -50,7 +76,7 @@ func Load(name string, data interface{}) error {
 	// Don't load anything if the file doesn't exist
 	_, err := os.Stat(p)
 	if os.IsNotExist(err) {
-		log.Printf("File %s didn't exist, loading with fresh data\n", p)
+		logger.Info("File didn't exist, loading with fresh data", "file", p)
 		return nil
 	}
 
@@ -58,16 +84,25 @@ func Load(name string, data interface{}) error {
 	if b, err := ioutil.ReadFile(p); err != nil {
 		return err
 	} else if len(b) == 0 {
-		log.Printf("File %s was empty, loading with fresh data\n", p)
+		logger.Warn("File was empty, loading with fresh data", "file", p)
 		return nil
 	} else if err := json.Unmarshal(b, data); err != nil {
 		return fmt.Errorf("failed to load file %s error: %s", p, err)
+	} else {
+		logger.Info("Loaded", "file", p, "bytes", len(b))
 	}
-
-	log.Printf("Loaded %s\n", p)
 	return nil
 }
 
+// Size returns the size in bytes of a saved file
+func Size(name string) (int64, error) {
+	info, err := os.Stat(jsonPath(name))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
 // saveLoadFunc defines a type of function to save or load an interface
 type saveLoadFunc func(string, interface{}) error
 
@@ -28,6 +28,16 @@ func TestPersistence_LoadSave(t *testing.T) {
 	dummy = Dummy{}
 	assert.NoError(t, Load("test", &dummy), "Failed to load in dummy file")
 	assert.Equal(t, true, dummy.Success, "Did not successfully load true value from file")
+
+	// Saving over the file leaves no temporary files behind
+	assert.NoError(t, Save("test", dummy), "Failed to save over dummy file")
+	files, err := ioutil.ReadDir(tmp)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1, "Save left temporary files behind")
+
+	size, err := Size("test")
+	assert.NoError(t, err)
+	assert.Equal(t, files[0].Size(), size)
 }
 
 func TestPersistence_LoadSaveAll(t *testing.T) {
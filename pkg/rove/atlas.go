@@ -15,4 +15,22 @@ type Atlas interface {
 
 	// QueryPosition queries a position on the atlas
 	QueryPosition(v maths.Vector) (roveapi.Tile, Object)
+
+	// QueryGenerated queries a position on the atlas without generating it, ok is false if it hasn't been generated
+	QueryGenerated(v maths.Vector) (tile roveapi.Tile, obj Object, ok bool)
+
+	// Stats summarises the atlas for monitoring
+	Stats() AtlasStats
+}
+
+// AtlasStats summarises the size and contents of an atlas
+type AtlasStats struct {
+	// Chunks is the number of generated chunks
+	Chunks int
+
+	// Bytes is an estimate of the memory used by the tiles and objects
+	Bytes int
+
+	// Objects counts the objects of each type
+	Objects map[roveapi.Object]int
 }
@@ -1,6 +1,7 @@
 package rove
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/mdiluz/rove/pkg/maths"
@@ -195,6 +196,20 @@ func TestAtlas_GetSetObject(t *testing.T) {
 	assert.Equal(t, Object{Type: roveapi.Object_RockSmall}, obj)
 }
 
+func TestAtlas_Stats(t *testing.T) {
+	// The first chunk is generated up front
+	a := NewChunkAtlas(10)
+	assert.Equal(t, 1, a.Stats().Chunks)
+	assert.GreaterOrEqual(t, a.Stats().Bytes, 100)
+
+	// Touching a far tile generates only its chunk
+	a.SetObject(maths.Vector{X: 25, Y: 25}, Object{Type: roveapi.Object_RockLarge})
+	stats := a.Stats()
+	assert.Equal(t, 2, stats.Chunks)
+	assert.GreaterOrEqual(t, stats.Objects[roveapi.Object_RockLarge], 1)
+	assert.GreaterOrEqual(t, stats.Bytes, 200)
+}
+
 func TestAtlas_Grown(t *testing.T) {
 	// Start with a small example
 	a := NewChunkAtlas(2).(*chunkBasedAtlas)
@@ -255,3 +270,30 @@ func TestAtlas_WorldGen(t *testing.T) {
 	// Spawn a large world
 	_, _ = a.QueryPosition(maths.Vector{X: 20, Y: 20})
 }
+
+func TestAtlas_Seed(t *testing.T) {
+	// Query a spread of tiles from an atlas
+	query := func(a Atlas) (tiles []roveapi.Tile) {
+		for i := -20; i < 20; i += 3 {
+			tile, _ := a.QueryPosition(maths.Vector{X: i, Y: i * 2})
+			tiles = append(tiles, tile)
+		}
+		return
+	}
+
+	// Different seeds generate different worlds
+	assert.NotEqual(t, query(NewSeededChunkAtlas(8, 1)), query(NewSeededChunkAtlas(8, 2)))
+
+	// Loading an atlas keeps its seed for generating new chunks, whatever it's loaded into
+	b, err := json.Marshal(NewSeededChunkAtlas(8, 1))
+	assert.NoError(t, err)
+	loaded := NewSeededChunkAtlas(8, 2)
+	assert.NoError(t, json.Unmarshal(b, loaded))
+	assert.Equal(t, int64(1), loaded.(*chunkBasedAtlas).Seed)
+	assert.Equal(t, query(NewSeededChunkAtlas(8, 1)), query(loaded))
+
+	// Atlases saved without a seed used the default
+	loaded = NewSeededChunkAtlas(8, 2)
+	assert.NoError(t, json.Unmarshal([]byte(`{"ChunkSize": 8}`), loaded))
+	assert.Equal(t, int64(DefaultSeed), loaded.(*chunkBasedAtlas).Seed)
+}
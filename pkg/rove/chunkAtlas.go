@@ -1,8 +1,10 @@
 package rove
 
 import (
-	"log"
+	"encoding/json"
+	"unsafe"
 
+	"github.com/mdiluz/rove/pkg/logging"
 	"github.com/mdiluz/rove/pkg/maths"
 	"github.com/mdiluz/rove/proto/roveapi"
 )
@@ -32,29 +34,56 @@ type chunkBasedAtlas struct {
 	// ChunkSize is the x/y dimensions of each square chunk
 	ChunkSize int
 
+	// Seed is the seed for generating new chunks
+	Seed int64
+
 	// worldGen is the internal world generator
 	worldGen WorldGen
+
+	// log records the atlas growing
+	log *logging.Logger
 }
 
 const (
-	noiseSeed = 1024
+	// DefaultSeed is the world generation seed used when none is given
+	DefaultSeed = 1024
 )
 
 // NewChunkAtlas creates a new empty atlas
 func NewChunkAtlas(chunkSize int) Atlas {
+	return NewSeededChunkAtlas(chunkSize, DefaultSeed)
+}
+
+// NewSeededChunkAtlas creates a new empty atlas generated from a seed
+func NewSeededChunkAtlas(chunkSize int, seed int64) Atlas {
 	// Start up with one chunk
 	a := chunkBasedAtlas{
 		ChunkSize:  chunkSize,
 		Chunks:     make([]chunk, 1),
 		LowerBound: maths.Vector{X: 0, Y: 0},
 		UpperBound: maths.Vector{X: chunkSize, Y: chunkSize},
-		worldGen:   NewNoiseWorldGen(noiseSeed),
+		Seed:       seed,
+		worldGen:   NewNoiseWorldGen(seed),
 	}
 	// Initialise the first chunk
 	a.populate(0)
 	return &a
 }
 
+// UnmarshalJSON loads the atlas and sets up the generator for its seed
+func (a *chunkBasedAtlas) UnmarshalJSON(b []byte) error {
+	// Atlases saved before seeds were stored all used the default
+	a.Seed = DefaultSeed
+
+	type atlas chunkBasedAtlas
+	if err := json.Unmarshal(b, (*atlas)(a)); err != nil {
+		return err
+	}
+
+	a.worldGen = NewNoiseWorldGen(a.Seed)
+	return nil
+}
+
 // SetTile sets an individual tile's kind
 func (a *chunkBasedAtlas) SetTile(v maths.Vector, tile roveapi.Tile) {
 	c := a.worldSpaceToChunkWithGrow(v)
@@ -79,6 +108,43 @@ func (a *chunkBasedAtlas) QueryPosition(v maths.Vector) (roveapi.Tile, Object) {
 	return roveapi.Tile(chunk.Tiles[i]), chunk.Objects[i]
 }
 
+// QueryGenerated queries a position on the atlas without growing or generating it
+func (a *chunkBasedAtlas) QueryGenerated(v maths.Vector) (roveapi.Tile, Object, bool) {
+	if v.X < a.LowerBound.X || v.Y < a.LowerBound.Y || v.X >= a.UpperBound.X || v.Y >= a.UpperBound.Y {
+		return roveapi.Tile_TileUnknown, Object{}, false
+	}
+
+	chunk := a.Chunks[a.worldSpaceToChunkIndex(v)]
+	if chunk.Tiles == nil {
+		return roveapi.Tile_TileUnknown, Object{}, false
+	}
+	i := a.chunkTileIndex(a.worldSpaceToChunkLocal(v))
+	return roveapi.Tile(chunk.Tiles[i]), chunk.Objects[i], true
+}
+
+// Stats summarises the generated chunks and their objects
+func (a *chunkBasedAtlas) Stats() AtlasStats {
+	stats := AtlasStats{Objects: make(map[roveapi.Object]int)}
+	for _, c := range a.Chunks {
+		if c.Tiles == nil {
+			continue
+		}
+
+		stats.Chunks++
+		stats.Bytes += len(c.Tiles)
+		for _, o := range c.Objects {
+			stats.Objects[o.Type]++
+			stats.Bytes += int(unsafe.Sizeof(o)) + len(o.Data)
+		}
+	}
+	return stats
+}
+
+// setLogger sets the logger for the atlas
+func (a *chunkBasedAtlas) setLogger(l *logging.Logger) {
+	a.log = l
+}
+
 // chunkTileID returns the tile index within a chunk
 func (a *chunkBasedAtlas) chunkTileIndex(local maths.Vector) int {
 	return local.X + local.Y*a.ChunkSize
@@ -208,10 +274,11 @@ func (a *chunkBasedAtlas) worldSpaceToChunkWithGrow(v maths.Vector) int {
 		UpperBound: upper,
 		Chunks:     make([]chunk, size.X*size.Y),
 		worldGen:   a.worldGen,
+		log:        a.log,
 	}
 
 	// Log that we're resizing
-	log.Printf("Re-allocating world, old: %+v,%+v new: %+v,%+v\n", a.LowerBound, a.UpperBound, newAtlas.LowerBound, newAtlas.UpperBound)
+	a.log.Debug("Re-allocating atlas", "old_lower", a.LowerBound, "old_upper", a.UpperBound, "new_lower", newAtlas.LowerBound, "new_upper", newAtlas.UpperBound)
 
 	// Copy all old chunks into the new atlas
 	for chunk, chunkData := range a.Chunks {
@@ -79,7 +79,7 @@ func TestCommand_Stash(t *testing.T) {
 	info, err = w.GetRover(name)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(info.Inventory))
-	assert.Equal(t, Object{Type: roveapi.Object_RockSmall}, info.Inventory[0])
+	assert.Equal(t, InventoryItem{Object: Object{Type: roveapi.Object_RockSmall}, Count: 1}, info.Inventory[0])
 
 	// Check it's no longer on the atlas
 	_, obj := w.Atlas.QueryPosition(info.Pos)
@@ -171,7 +171,7 @@ func TestCommand_Salvage(t *testing.T) {
 
 func TestCommand_Transfer(t *testing.T) {
 	w := NewWorld(8)
-	acc, err := w.Accountant.RegisterAccount("tmp")
+	acc, _, err := w.Accountant.RegisterAccount("tmp")
 	assert.NoError(t, err)
 	nameA, err := w.SpawnRover(acc.Name)
 	assert.NoError(t, err)
@@ -199,9 +199,7 @@ func TestCommand_Transfer(t *testing.T) {
 	assert.Empty(t, w.CommandQueue[infoB.Name])
 
 	// Verify the account now controls the new rover
-	accountRover, err := w.Accountant.GetValue(acc.Name, "rover")
-	assert.NoError(t, err)
-	assert.Equal(t, infoB.Name, accountRover)
+	assert.Equal(t, []string{infoB.Name}, w.Fleet(acc.Name))
 
 	// Verify the position now has a dormant rover
 	_, obj := w.Atlas.QueryPosition(infoA.Pos)
@@ -249,57 +247,356 @@ func TestCommand_Wait(t *testing.T) {
 	assert.Equal(t, roveapi.SailPosition_CatchingWind, r.SailPosition)
 }
 
-func TestCommand_Upgrade(t *testing.T) {
+func TestCommand_Drop(t *testing.T) {
 	w := NewWorld(8)
 	name, err := w.SpawnRover("")
 	assert.NoError(t, err)
 	rover, ok := w.Rovers[name]
 	assert.True(t, ok)
 
-	// Try an invalid upgrade
-	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_upgrade})
+	// Try invalid drops
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_drop, Index: -1})
+	assert.Error(t, err)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_drop, Object: roveapi.Object(100)})
 	assert.Error(t, err)
 
-	// Try a valid command but without the parts
-	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_upgrade, Upgrade: roveapi.RoverUpgrade_Capacity})
+	// Clear the current and northern tiles
+	north := rover.Pos.Added(maths.Vector{Y: 1})
+	w.Atlas.SetObject(rover.Pos, Object{Type: roveapi.Object_ObjectUnknown})
+	w.Atlas.SetObject(north, Object{Type: roveapi.Object_ObjectUnknown})
+
+	// Try a drop with an empty inventory
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_drop})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "tried")
+
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, 2)
+	rover.Inventory.Add(Object{Type: roveapi.Object_RoverParts}, 1)
+
+	// Drop by index onto the current tile
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_drop, Index: 1})
+	assert.NoError(t, err)
+	w.Tick()
+	_, obj := w.Atlas.QueryPosition(rover.Pos)
+	assert.Equal(t, roveapi.Object_RoverParts, obj.Type)
+	assert.Equal(t, 1, len(rover.Inventory))
+
+	// Dropping onto the now occupied tile should fail
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_drop, Object: roveapi.Object_RockSmall})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "occupied")
+	assert.Equal(t, 2, rover.Inventory.Count(roveapi.Object_RockSmall))
+
+	// Drop by type onto the adjacent tile
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_drop, Object: roveapi.Object_RockSmall, Bearing: roveapi.Bearing_North})
+	assert.NoError(t, err)
+	w.Tick()
+	_, obj = w.Atlas.QueryPosition(north)
+	assert.Equal(t, roveapi.Object_RockSmall, obj.Type)
+	assert.Equal(t, 1, rover.Inventory.Count(roveapi.Object_RockSmall))
+}
+
+func TestCommand_Craft(t *testing.T) {
+	w := NewWorld(8)
+	name, err := w.SpawnRover("")
 	assert.NoError(t, err)
+	rover, ok := w.Rovers[name]
+	assert.True(t, ok)
+
+	// Try an unknown recipe
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_craft, Recipe: "unknown"})
+	assert.Error(t, err)
+
+	recipe, ok := w.Recipes.Find("hull-plate")
+	assert.True(t, ok)
 
-	// Ensure nothing changed and we logged the attempt
-	pre := rover.Capacity
+	// Try without the ingredients
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_craft, Recipe: recipe.Name})
+	assert.NoError(t, err)
 	w.Tick()
-	assert.Equal(t, pre, rover.Capacity)
 	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "tried")
+	assert.Empty(t, rover.Crafting)
+
+	// Give the rover the ingredients and one spare rock
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, 4)
+	rover.Inventory.Add(Object{Type: roveapi.Object_RoverParts}, 1)
 
-	// One non-part item
-	rover.Inventory = []Object{
-		{
-			Type: roveapi.Object_RoverParts,
-		},
-		{
-			Type: roveapi.Object_RoverParts,
-		},
-		{
-			Type: roveapi.Object_RockSmall,
-		},
-		{
-			Type: roveapi.Object_RoverParts,
-		},
-		{
-			Type: roveapi.Object_RoverParts,
-		},
-		{
-			Type: roveapi.Object_RoverParts,
-		},
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_craft, Recipe: recipe.Name})
+	assert.NoError(t, err)
+	w.Tick()
+
+	// The ingredients should be used up straight away
+	assert.Equal(t, recipe.Name, rover.Crafting)
+	assert.Equal(t, 1, rover.Inventory.Count(roveapi.Object_RockSmall))
+	assert.Equal(t, 0, rover.Inventory.Count(roveapi.Object_RoverParts))
+	assert.Equal(t, 0, rover.Inventory.Count(recipe.Product))
+
+	// Tick until just before completion
+	for i := 1; i < recipe.Ticks-1; i++ {
+		w.Tick()
+		assert.Equal(t, recipe.Name, rover.Crafting)
 	}
 
-	// Try a valid command again
-	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_upgrade, Upgrade: roveapi.RoverUpgrade_Capacity})
+	// Fill up the inventory to block the product
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, rover.Capacity-rover.Inventory.Weight())
+	w.Tick()
+	assert.Equal(t, recipe.Name, rover.Crafting)
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "full")
+
+	// Free up space and the product should arrive
+	rover.Inventory.Remove(roveapi.Object_RockSmall, 2)
+	w.Tick()
+	assert.Empty(t, rover.Crafting)
+	assert.Equal(t, 1, rover.Inventory.Count(recipe.Product))
+}
+
+func TestCommand_InstallUninstall(t *testing.T) {
+	w := NewWorld(8)
+	name, err := w.SpawnRover("")
 	assert.NoError(t, err)
+	rover, ok := w.Rovers[name]
+	assert.True(t, ok)
 
-	// Check that the capacity increases on the tick and all the parts are used
-	pre = rover.Capacity
+	// Try invalid commands
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_install, Index: -1})
+	assert.Error(t, err)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_uninstall})
+	assert.Error(t, err)
+
+	// Non-components can't be installed
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, 1)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_install, Object: roveapi.Object_RockSmall})
+	assert.NoError(t, err)
 	w.Tick()
-	assert.Equal(t, pre+1, rover.Capacity)
-	assert.Equal(t, 1, len(rover.Inventory))
-	assert.Equal(t, roveapi.Object_RockSmall, rover.Inventory[0].Type)
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "not a component")
+	assert.Empty(t, rover.Loadout)
+
+	// Install two hull plates, only the first should fit
+	rover.Inventory.Add(Object{Type: roveapi.Object_HullPlate}, 2)
+	component, ok := FindComponent(roveapi.Object_HullPlate)
+	assert.True(t, ok)
+	pre := rover.MaximumIntegrity
+	err = w.Enqueue(name,
+		&roveapi.Command{Command: roveapi.CommandType_install, Object: roveapi.Object_HullPlate},
+		&roveapi.Command{Command: roveapi.CommandType_install, Index: 1})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Equal(t, pre+component.MaximumIntegrity, rover.MaximumIntegrity)
+	assert.Equal(t, roveapi.Object_HullPlate, rover.Loadout[roveapi.ComponentSlot_Hull].Type)
+	assert.Equal(t, 1, rover.Inventory.Count(roveapi.Object_HullPlate))
+	w.Tick()
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "already held")
+	assert.Equal(t, 1, rover.Inventory.Count(roveapi.Object_HullPlate))
+
+	// Uninstall it again and check integrity is clamped
+	rover.Integrity = rover.MaximumIntegrity
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_uninstall, Slot: roveapi.ComponentSlot_Hull})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Equal(t, pre, rover.MaximumIntegrity)
+	assert.Equal(t, pre, rover.Integrity)
+	assert.Empty(t, rover.Loadout)
+	assert.Equal(t, 2, rover.Inventory.Count(roveapi.Object_HullPlate))
+
+	// A drill bit can't be uninstalled if the inventory relies on its capacity
+	rover.Inventory.Add(Object{Type: roveapi.Object_DrillBit}, 1)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_install, Object: roveapi.Object_DrillBit})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Equal(t, roveapi.Object_DrillBit, rover.Loadout[roveapi.ComponentSlot_Drill].Type)
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, rover.Capacity-rover.Inventory.Weight())
+
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_uninstall, Slot: roveapi.ComponentSlot_Drill})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "could not hold")
+	assert.Equal(t, roveapi.Object_DrillBit, rover.Loadout[roveapi.ComponentSlot_Drill].Type)
+}
+
+func TestCommand_Build(t *testing.T) {
+	w := NewWorld(8)
+	name, err := w.SpawnRover("")
+	assert.NoError(t, err)
+	rover, ok := w.Rovers[name]
+	assert.True(t, ok)
+
+	// Try invalid builds
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_build, Object: roveapi.Object_RockSmall})
+	assert.Error(t, err)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_build, Object: roveapi.Object_Beacon, Data: []byte("toolong")})
+	assert.Error(t, err)
+
+	// Clear the northern tile
+	north := rover.Pos.Added(maths.Vector{Y: 1})
+	w.Atlas.SetObject(north, Object{Type: roveapi.Object_ObjectUnknown})
+
+	// Try without the materials
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_build, Object: roveapi.Object_Beacon, Data: []byte("hi"), Bearing: roveapi.Bearing_North})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "lacked")
+	_, obj := w.Atlas.QueryPosition(north)
+	assert.Equal(t, roveapi.Object_ObjectUnknown, obj.Type)
+
+	// Build the beacon
+	rover.Inventory.Add(Object{Type: roveapi.Object_AntennaBooster}, 1)
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, 2)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_build, Object: roveapi.Object_Beacon, Data: []byte("hi"), Bearing: roveapi.Bearing_North})
+	assert.NoError(t, err)
+	w.Tick()
+	_, obj = w.Atlas.QueryPosition(north)
+	assert.Equal(t, roveapi.Object_Beacon, obj.Type)
+	assert.Empty(t, rover.Inventory)
+
+	// The beacon is heard once while the rover stays in range
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "hi")
+	logs := len(rover.Logs)
+	w.Tick()
+	w.Tick()
+	assert.Len(t, rover.Logs, logs)
+
+	// Until its message changes
+	_, obj = w.Atlas.QueryPosition(north)
+	assert.NoError(t, obj.SetStructure(Structure{Message: []byte("bye")}))
+	w.Atlas.SetObject(north, obj)
+	w.Tick()
+	assert.Len(t, rover.Logs, logs+1)
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "bye")
+
+	// Building on the occupied tile should fail
+	rover.Inventory.Add(Object{Type: roveapi.Object_HullPlate}, 1)
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, 4)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_build, Object: roveapi.Object_Cache, Bearing: roveapi.Bearing_North})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Equal(t, 5, rover.Inventory.Count(roveapi.Object_RockSmall)+rover.Inventory.Count(roveapi.Object_HullPlate))
+}
+
+func TestCommand_SolarStation(t *testing.T) {
+	w := NewWorld(8)
+	name, err := w.SpawnRover("")
+	assert.NoError(t, err)
+	rover, ok := w.Rovers[name]
+	assert.True(t, ok)
+
+	east := rover.Pos.Added(maths.Vector{X: 1})
+	w.Atlas.SetObject(east, Object{Type: roveapi.Object_ObjectUnknown})
+
+	rover.Inventory.Add(Object{Type: roveapi.Object_SolarPanel}, 2)
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, 2)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_build, Object: roveapi.Object_SolarStation, Bearing: roveapi.Bearing_East})
+	assert.NoError(t, err)
+	w.Tick()
+	_, obj := w.Atlas.QueryPosition(east)
+	assert.Equal(t, roveapi.Object_SolarStation, obj.Type)
+
+	// No recharge during the day
+	rover.Charge = 0
+	w.Tick()
+	assert.Equal(t, 0, rover.Charge)
+
+	// But the station recharges the rover at night
+	w.CurrentTicks = w.TicksPerDay / 2
+	w.Tick()
+	assert.Equal(t, 1, rover.Charge)
+
+	// Logging it only the once that night
+	logs := len(rover.Logs)
+	w.Tick()
+	assert.Equal(t, 2, rover.Charge)
+	assert.Len(t, rover.Logs, logs)
+	w.CurrentTicks += w.TicksPerDay
+	w.Tick()
+	assert.Len(t, rover.Logs, logs+1)
+}
+
+func TestCommand_DepositWithdraw(t *testing.T) {
+	w := NewWorld(8)
+	name, err := w.SpawnRover("")
+	assert.NoError(t, err)
+	rover, ok := w.Rovers[name]
+	assert.True(t, ok)
+
+	// Place a cache on the current tile
+	cache := Object{Type: roveapi.Object_Cache}
+	assert.NoError(t, cache.SetStructure(Structure{}))
+	w.Atlas.SetObject(rover.Pos, cache)
+
+	// Try to withdraw from the empty cache
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_withdraw})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Contains(t, rover.Logs[len(rover.Logs)-1].Text, "tried")
+
+	// Deposit by object type
+	rover.Inventory.Add(Object{Type: roveapi.Object_RoverParts}, 2)
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_deposit, Object: roveapi.Object_RoverParts})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Equal(t, 1, rover.Inventory.Count(roveapi.Object_RoverParts))
+
+	_, obj := w.Atlas.QueryPosition(rover.Pos)
+	s, err := obj.Structure()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, s.Inventory.Count(roveapi.Object_RoverParts))
+
+	// And withdraw it again
+	err = w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_withdraw, Object: roveapi.Object_RoverParts})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Equal(t, 2, rover.Inventory.Count(roveapi.Object_RoverParts))
+
+	_, obj = w.Atlas.QueryPosition(rover.Pos)
+	s, err = obj.Structure()
+	assert.NoError(t, err)
+	assert.Empty(t, s.Inventory)
+}
+
+func TestCommand_Claim(t *testing.T) {
+	w := NewWorld(8)
+	w.FleetCap = 2
+	nameA, err := w.SpawnRover("tmp")
+	assert.NoError(t, err)
+
+	infoA, err := w.GetRover(nameA)
+	assert.NoError(t, err)
+
+	// Try to claim with nothing there
+	w.Atlas.SetObject(infoA.Pos, Object{Type: roveapi.Object_ObjectUnknown})
+	err = w.Enqueue(nameA, &roveapi.Command{Command: roveapi.CommandType_claim})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Len(t, w.Fleet("tmp"), 1)
+
+	// Drop a dormant rover on the current position and claim it
+	dormant := func(name string) {
+		r := DefaultRover()
+		r.Name = name
+		data, err := json.Marshal(r)
+		assert.NoError(t, err)
+		w.Atlas.SetObject(infoA.Pos, Object{Type: roveapi.Object_RoverDormant, Data: data})
+	}
+	dormant("abc")
+	err = w.Enqueue(nameA, &roveapi.Command{Command: roveapi.CommandType_claim})
+	assert.NoError(t, err)
+	w.Tick()
+
+	// Both rovers should be live in the fleet
+	fleet := w.Fleet("tmp")
+	assert.Len(t, fleet, 2)
+	assert.Contains(t, fleet, nameA)
+	assert.Contains(t, fleet, "abc")
+	_, obj := w.Atlas.QueryPosition(infoA.Pos)
+	assert.Equal(t, roveapi.Object_ObjectUnknown, obj.Type)
+
+	// The fleet cap stops any more claims
+	dormant("def")
+	err = w.Enqueue(nameA, &roveapi.Command{Command: roveapi.CommandType_claim})
+	assert.NoError(t, err)
+	w.Tick()
+	assert.Len(t, w.Fleet("tmp"), 2)
+	_, obj = w.Atlas.QueryPosition(infoA.Pos)
+	assert.Equal(t, roveapi.Object_RoverDormant, obj.Type)
 }
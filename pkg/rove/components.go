@@ -0,0 +1,53 @@
+package rove
+
+import (
+	"github.com/mdiluz/rove/proto/roveapi"
+)
+
+// Component describes the equipment slot an object installs into and the specifications it adds to a rover
+type Component struct {
+	// Slot is the equipment slot the component installs into
+	Slot roveapi.ComponentSlot
+
+	// Range is added to the rover range
+	Range int
+
+	// Capacity is added to the rover inventory capacity
+	Capacity int
+
+	// MaximumIntegrity is added to the rover maximum integrity
+	MaximumIntegrity int
+
+	// MaximumCharge is added to the rover maximum charge
+	MaximumCharge int
+}
+
+// components is the set of all installable objects
+var components = map[roveapi.Object]Component{
+	roveapi.Object_AntennaBooster: {
+		Slot:  roveapi.ComponentSlot_Antenna,
+		Range: 5,
+	},
+	roveapi.Object_BatteryCell: {
+		Slot:          roveapi.ComponentSlot_Battery,
+		MaximumCharge: 5,
+	},
+	roveapi.Object_HullPlate: {
+		Slot:             roveapi.ComponentSlot_Hull,
+		MaximumIntegrity: 5,
+	},
+	roveapi.Object_SolarPanel: {
+		Slot:          roveapi.ComponentSlot_Sail,
+		MaximumCharge: 3,
+	},
+	roveapi.Object_DrillBit: {
+		Slot:     roveapi.ComponentSlot_Drill,
+		Capacity: 5,
+	},
+}
+
+// FindComponent returns the component details for an object type
+func FindComponent(t roveapi.Object) (Component, bool) {
+	c, ok := components[t]
+	return c, ok
+}
@@ -0,0 +1,43 @@
+package rove
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRoverNotFound is returned when no rover matches the given name
+var ErrRoverNotFound = errors.New("no rover matching id")
+
+// ErrInvalidArgument is returned when a request is given an invalid value
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrFleetFull is returned when an account already has as many rovers as the fleet cap allows
+var ErrFleetFull = errors.New("fleet is full")
+
+// ErrNoSpace is returned when there's no free tile to leave an object on
+var ErrNoSpace = errors.New("no free space")
+
+// CommandError describes an invalid field in a requested command
+type CommandError struct {
+	// Index is the position of the invalid command in the request
+	Index int
+
+	// Field is the name of the invalid command field
+	Field string
+
+	// Description describes why the field is invalid
+	Description string
+}
+
+// Error returns the error description
+func (e *CommandError) Error() string {
+	return e.Description
+}
+
+// invalidCommand creates a CommandError for a field with a formatted description
+func invalidCommand(field string, format string, a ...interface{}) *CommandError {
+	return &CommandError{
+		Field:       field,
+		Description: fmt.Sprintf(format, a...),
+	}
+}
@@ -0,0 +1,131 @@
+package rove
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/mdiluz/rove/pkg/maths"
+	"github.com/mdiluz/rove/proto/roveapi"
+)
+
+// InventoryItem describes a stack of identical objects held in an inventory
+type InventoryItem struct {
+	// Object is the object held in this stack, including any per-item data
+	Object
+
+	// Count is the number of objects in this stack
+	Count int
+}
+
+// Inventory represents a set of object stacks carried by a rover
+type Inventory []InventoryItem
+
+// Weight returns the total weight of all objects in the inventory
+func (i Inventory) Weight() (weight int) {
+	for _, item := range i {
+		weight += item.Weight() * item.Count
+	}
+	return
+}
+
+// Count returns the total number of objects of a type in the inventory
+func (i Inventory) Count(t roveapi.Object) (count int) {
+	for _, item := range i {
+		if item.Type == t {
+			count += item.Count
+		}
+	}
+	return
+}
+
+// Contains checks if the inventory holds at least the given number of each object type
+func (i Inventory) Contains(counts map[roveapi.Object]int) bool {
+	for t, n := range counts {
+		if i.Count(t) < n {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns the index of the first stack of a type, or -1 if there is none
+func (i Inventory) Find(t roveapi.Object) int {
+	for n, item := range i {
+		if item.Type == t {
+			return n
+		}
+	}
+	return -1
+}
+
+// Add adds a number of objects to the inventory
+// Objects only stack with others of the same type and identical data
+func (i *Inventory) Add(o Object, count int) {
+	if count <= 0 {
+		return
+	}
+
+	for n := range *i {
+		item := &(*i)[n]
+		if item.Type == o.Type && bytes.Equal(item.Data, o.Data) {
+			item.Count += count
+			return
+		}
+	}
+
+	*i = append(*i, InventoryItem{Object: o, Count: count})
+}
+
+// Remove removes up to a number of objects of a type from the inventory, returning the number removed
+func (i *Inventory) Remove(t roveapi.Object, count int) (removed int) {
+	var n Inventory
+	for _, item := range *i {
+		if item.Type == t && removed < count {
+			take := maths.Min(item.Count, count-removed)
+			item.Count -= take
+			removed += take
+		}
+
+		// Drop any emptied stacks
+		if item.Count > 0 {
+			n = append(n, item)
+		}
+	}
+	*i = n
+	return
+}
+
+// Take removes a single object from the stack at an index
+func (i *Inventory) Take(index int) (Object, bool) {
+	if index < 0 || index >= len(*i) {
+		return Object{}, false
+	}
+
+	item := &(*i)[index]
+	o := item.Object
+	item.Count--
+
+	// Remove the stack entirely once empty, keeping the order intact
+	if item.Count <= 0 {
+		*i = append((*i)[:index], (*i)[index+1:]...)
+	}
+	return o, true
+}
+
+// UnmarshalJSON loads an inventory, also accepting the older flat list of objects
+func (i *Inventory) UnmarshalJSON(b []byte) error {
+	var items []InventoryItem
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	// Re-add everything so flat objects (which have no count) become stacks
+	*i = nil
+	for _, item := range items {
+		if item.Count == 0 {
+			item.Count = 1
+		}
+		i.Add(item.Object, item.Count)
+	}
+	return nil
+}
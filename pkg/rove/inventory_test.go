@@ -0,0 +1,66 @@
+package rove
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventory_AddRemove(t *testing.T) {
+	var inv Inventory
+
+	// Identical objects should stack
+	inv.Add(Object{Type: roveapi.Object_RockSmall}, 2)
+	inv.Add(Object{Type: roveapi.Object_RoverParts}, 1)
+	inv.Add(Object{Type: roveapi.Object_RockSmall}, 1)
+	assert.Equal(t, 2, len(inv))
+	assert.Equal(t, 3, inv.Count(roveapi.Object_RockSmall))
+	assert.Equal(t, 4, inv.Weight())
+
+	// Objects with different data should not
+	inv.Add(Object{Type: roveapi.Object_RoverParts, Data: []byte("a")}, 1)
+	assert.Equal(t, 3, len(inv))
+	assert.Equal(t, 2, inv.Count(roveapi.Object_RoverParts))
+
+	// Removing more than we have should only remove what's there
+	assert.Equal(t, 2, inv.Remove(roveapi.Object_RoverParts, 5))
+	assert.Equal(t, 1, len(inv))
+	assert.Equal(t, -1, inv.Find(roveapi.Object_RoverParts))
+
+	// Take from the remaining stack until it's gone
+	for i := 0; i < 3; i++ {
+		o, ok := inv.Take(0)
+		assert.True(t, ok)
+		assert.Equal(t, roveapi.Object_RockSmall, o.Type)
+	}
+	assert.Empty(t, inv)
+
+	_, ok := inv.Take(0)
+	assert.False(t, ok)
+}
+
+func TestInventory_UnmarshalFlat(t *testing.T) {
+	// Older saves stored the inventory as a flat list of objects
+	flat := []Object{
+		{Type: roveapi.Object_RockSmall},
+		{Type: roveapi.Object_RoverParts},
+		{Type: roveapi.Object_RockSmall},
+	}
+	b, err := json.Marshal(flat)
+	assert.NoError(t, err)
+
+	var inv Inventory
+	assert.NoError(t, json.Unmarshal(b, &inv))
+	assert.Equal(t, 2, len(inv))
+	assert.Equal(t, 2, inv.Count(roveapi.Object_RockSmall))
+	assert.Equal(t, 1, inv.Count(roveapi.Object_RoverParts))
+
+	// And the new format should round trip
+	b, err = json.Marshal(inv)
+	assert.NoError(t, err)
+	var loaded Inventory
+	assert.NoError(t, json.Unmarshal(b, &loaded))
+	assert.Equal(t, inv, loaded)
+}
@@ -18,6 +18,8 @@ func (o *Object) IsBlocking() bool {
 	var blocking = [...]roveapi.Object{
 		roveapi.Object_RoverLive,
 		roveapi.Object_RockLarge,
+		roveapi.Object_Beacon,
+		roveapi.Object_SolarStation,
 	}
 
 	for _, t := range blocking {
@@ -33,6 +35,11 @@ func (o *Object) IsStashable() bool {
 	var stashable = [...]roveapi.Object{
 		roveapi.Object_RockSmall,
 		roveapi.Object_RoverParts,
+		roveapi.Object_SolarPanel,
+		roveapi.Object_AntennaBooster,
+		roveapi.Object_HullPlate,
+		roveapi.Object_BatteryCell,
+		roveapi.Object_DrillBit,
 	}
 
 	for _, t := range stashable {
@@ -42,3 +49,21 @@ func (o *Object) IsStashable() bool {
 	}
 	return false
 }
+
+// Weight returns the inventory weight of a single object of this type
+func (o *Object) Weight() int {
+	var weights = map[roveapi.Object]int{
+		roveapi.Object_RockSmall:      1,
+		roveapi.Object_RoverParts:     1,
+		roveapi.Object_SolarPanel:     2,
+		roveapi.Object_AntennaBooster: 2,
+		roveapi.Object_HullPlate:      2,
+		roveapi.Object_BatteryCell:    2,
+		roveapi.Object_DrillBit:       2,
+	}
+
+	if w, ok := weights[o.Type]; ok {
+		return w
+	}
+	return 1
+}
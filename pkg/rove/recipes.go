@@ -0,0 +1,166 @@
+package rove
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"gopkg.in/yaml.v2"
+)
+
+// Recipe describes how to craft a component out of inventory objects
+type Recipe struct {
+	// Name is the unique name used to request the recipe
+	Name string
+
+	// Ingredients are the objects consumed when crafting starts
+	Ingredients map[roveapi.Object]int
+
+	// Ticks is the number of ticks crafting takes to complete
+	Ticks int
+
+	// Product is the object produced once crafting completes
+	Product roveapi.Object
+}
+
+// Recipes is a set of craftable recipes
+type Recipes []Recipe
+
+// recipeFile describes a recipe as written in a recipes file, with objects given by name
+type recipeFile struct {
+	Name        string         `yaml:"name"`
+	Ingredients map[string]int `yaml:"ingredients"`
+	Ticks       int            `yaml:"ticks"`
+	Product     string         `yaml:"product"`
+}
+
+// DefaultRecipes returns the standard recipes
+func DefaultRecipes() Recipes {
+	return Recipes{
+		{
+			Name: "solar-panel",
+			Ingredients: map[roveapi.Object]int{
+				roveapi.Object_RoverParts: 2,
+				roveapi.Object_RockSmall:  1,
+			},
+			Ticks:   4,
+			Product: roveapi.Object_SolarPanel,
+		},
+		{
+			Name: "antenna-booster",
+			Ingredients: map[roveapi.Object]int{
+				roveapi.Object_RoverParts: 3,
+			},
+			Ticks:   5,
+			Product: roveapi.Object_AntennaBooster,
+		},
+		{
+			Name: "hull-plate",
+			Ingredients: map[roveapi.Object]int{
+				roveapi.Object_RockSmall:  3,
+				roveapi.Object_RoverParts: 1,
+			},
+			Ticks:   3,
+			Product: roveapi.Object_HullPlate,
+		},
+		{
+			Name: "battery-cell",
+			Ingredients: map[roveapi.Object]int{
+				roveapi.Object_RoverParts: 2,
+				roveapi.Object_RockSmall:  2,
+			},
+			Ticks:   4,
+			Product: roveapi.Object_BatteryCell,
+		},
+		{
+			Name: "drill-bit",
+			Ingredients: map[roveapi.Object]int{
+				roveapi.Object_RoverParts: 1,
+				roveapi.Object_RockSmall:  4,
+			},
+			Ticks:   3,
+			Product: roveapi.Object_DrillBit,
+		},
+	}
+}
+
+// LoadRecipes loads recipes from a YAML file, replacing the standard recipes entirely
+func LoadRecipes(path string) (Recipes, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file []recipeFile
+	if err := yaml.UnmarshalStrict(b, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse recipes file %s: %s", path, err)
+	}
+
+	var recipes Recipes
+	for _, f := range file {
+		recipe := Recipe{
+			Name:        f.Name,
+			Ingredients: make(map[roveapi.Object]int),
+			Ticks:       f.Ticks,
+		}
+
+		for name, count := range f.Ingredients {
+			o, ok := roveapi.Object_value[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: recipe %s has unknown ingredient: %s", ErrInvalidArgument, f.Name, name)
+			}
+			recipe.Ingredients[roveapi.Object(o)] = count
+		}
+
+		o, ok := roveapi.Object_value[f.Product]
+		if !ok {
+			return nil, fmt.Errorf("%w: recipe %s has unknown product: %s", ErrInvalidArgument, f.Name, f.Product)
+		}
+		recipe.Product = roveapi.Object(o)
+
+		recipes = append(recipes, recipe)
+	}
+
+	return recipes, recipes.Validate()
+}
+
+// Validate checks the recipes make sense
+func (r Recipes) Validate() error {
+	if len(r) == 0 {
+		return fmt.Errorf("%w: there must be at least one recipe", ErrInvalidArgument)
+	}
+
+	names := make(map[string]bool)
+	for _, recipe := range r {
+		switch {
+		case len(recipe.Name) == 0:
+			return fmt.Errorf("%w: recipes must have a name", ErrInvalidArgument)
+		case names[recipe.Name]:
+			return fmt.Errorf("%w: duplicate recipe: %s", ErrInvalidArgument, recipe.Name)
+		case len(recipe.Ingredients) == 0:
+			return fmt.Errorf("%w: recipe %s has no ingredients", ErrInvalidArgument, recipe.Name)
+		case recipe.Ticks < 1:
+			return fmt.Errorf("%w: recipe %s must take at least one tick", ErrInvalidArgument, recipe.Name)
+		case recipe.Product == roveapi.Object_ObjectUnknown:
+			return fmt.Errorf("%w: recipe %s has no product", ErrInvalidArgument, recipe.Name)
+		}
+		names[recipe.Name] = true
+
+		for o, count := range recipe.Ingredients {
+			if o == roveapi.Object_ObjectUnknown || count < 1 {
+				return fmt.Errorf("%w: recipe %s needs a positive count of known ingredients", ErrInvalidArgument, recipe.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Find returns the recipe with the given name
+func (r Recipes) Find(name string) (Recipe, bool) {
+	for _, recipe := range r {
+		if recipe.Name == name {
+			return recipe, true
+		}
+	}
+	return Recipe{}, false
+}
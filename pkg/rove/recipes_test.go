@@ -0,0 +1,97 @@
+package rove
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadRecipes(t *testing.T) {
+	assert.NoError(t, DefaultRecipes().Validate())
+
+	// The standard recipes file matches the built in recipes
+	recipes, err := LoadRecipes(path.Join("..", "..", "data", "recipes", "standard.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultRecipes(), recipes)
+
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-recipes-")
+	assert.NoError(t, err)
+	file := path.Join(tmp, "recipes.yaml")
+
+	// A custom file replaces the standard recipes entirely
+	assert.NoError(t, ioutil.WriteFile(file, []byte(`
+- name: quick-plate
+  ingredients:
+    RockSmall: 1
+  ticks: 1
+  product: HullPlate
+`), 0644))
+	recipes, err = LoadRecipes(file)
+	assert.NoError(t, err)
+	assert.Equal(t, Recipes{{
+		Name:        "quick-plate",
+		Ingredients: map[roveapi.Object]int{roveapi.Object_RockSmall: 1},
+		Ticks:       1,
+		Product:     roveapi.Object_HullPlate,
+	}}, recipes)
+
+	// Unknown fields and objects, and invalid recipes are rejected
+	for _, bad := range []string{
+		"- {name: a, ingredients: {RockSmall: 1}, tiks: 1, product: HullPlate}",
+		"- {name: a, ingredients: {Pebble: 1}, ticks: 1, product: HullPlate}",
+		"- {name: a, ingredients: {RockSmall: 1}, ticks: 1, product: Widget}",
+		"- {name: a, ingredients: {RockSmall: 0}, ticks: 1, product: HullPlate}",
+		"- {name: a, ingredients: {RockSmall: 1}, ticks: 0, product: HullPlate}",
+		"- {name: a, ticks: 1, product: HullPlate}",
+		"- {ingredients: {RockSmall: 1}, ticks: 1, product: HullPlate}",
+		"- {name: a, ingredients: {RockSmall: 1}, ticks: 1, product: HullPlate}\n- {name: a, ingredients: {RockSmall: 1}, ticks: 1, product: DrillBit}",
+		"[]",
+	} {
+		assert.NoError(t, ioutil.WriteFile(file, []byte(bad), 0644))
+		_, err = LoadRecipes(file)
+		assert.Error(t, err, bad)
+	}
+
+	_, err = LoadRecipes(path.Join(tmp, "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestWorld_SetRecipes(t *testing.T) {
+	w := NewWorld(8)
+	name, err := w.SpawnRover("")
+	assert.NoError(t, err)
+	rover := w.Rovers[name]
+
+	assert.Error(t, w.SetRecipes(nil))
+
+	// Only the new recipes can be crafted
+	assert.NoError(t, w.SetRecipes(Recipes{{
+		Name:        "quick-plate",
+		Ingredients: map[roveapi.Object]int{roveapi.Object_RockSmall: 1},
+		Ticks:       1,
+		Product:     roveapi.Object_HullPlate,
+	}}))
+	assert.Error(t, w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_craft, Recipe: "hull-plate"}))
+
+	rover.Inventory.Add(Object{Type: roveapi.Object_RockSmall}, 1)
+	assert.NoError(t, w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_craft, Recipe: "quick-plate"}))
+	w.Tick()
+	assert.Equal(t, 1, rover.Inventory.Count(roveapi.Object_HullPlate))
+
+	// The recipes are saved with the world
+	snapshot, err := w.Snapshot()
+	assert.NoError(t, err)
+	other := NewWorld(8)
+	assert.NoError(t, other.Restore(snapshot))
+	assert.Equal(t, w.Recipes, other.Recipes)
+
+	// Crafting a recipe that's been removed is stopped
+	rover.Crafting = "quick-plate"
+	rover.CraftTicks = 1
+	assert.NoError(t, w.SetRecipes(DefaultRecipes()))
+	assert.Empty(t, rover.Crafting)
+}
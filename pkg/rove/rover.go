@@ -3,7 +3,6 @@ package rove
 import (
 	"bufio"
 	"fmt"
-	"log"
 	"math/rand"
 	"os"
 	"time"
@@ -13,10 +12,6 @@ import (
 	"github.com/mdiluz/rove/proto/roveapi"
 )
 
-const (
-	maxLogEntries = 16
-)
-
 // RoverLogEntry describes a single log entry for the rover
 type RoverLogEntry struct {
 	// Time is the timestamp of the entry
@@ -26,6 +21,20 @@ type RoverLogEntry struct {
 	Text string
 }
 
+// RoverUpgrades are specification increases on top of the base values and the loadout
+type RoverUpgrades struct {
+	Range            int
+	Capacity         int
+	MaximumIntegrity int
+	MaximumCharge    int
+}
+
+// HeardBeacon is a beacon a rover is in range of and the message it was broadcasting
+type HeardBeacon struct {
+	Pos     maths.Vector
+	Message string
+}
+
 // Rover describes a single rover in the world
 type Rover struct {
 	// Unique name of this rover
@@ -37,25 +46,31 @@ type Rover struct {
 	// Bearing is the current direction the rover is facing
 	Bearing roveapi.Bearing
 
-	// Range represents the distance the unit's radar can see
+	// Loadout holds the component installed in each equipment slot
+	Loadout map[roveapi.ComponentSlot]Object
+
+	// Upgrades are kept from the upgrade command of older versions
+	Upgrades RoverUpgrades
+
+	// Range represents the distance the unit's radar can see, derived from the loadout
 	Range int
 
 	// Inventory represents any items the rover is carrying
-	Inventory []Object
+	Inventory Inventory
 
-	// Capacity is the maximum number of inventory items
+	// Capacity is the maximum total weight of inventory items, derived from the loadout
 	Capacity int
 
 	// Integrity represents current rover health
 	Integrity int
 
-	// MaximumIntegrity is the full integrity of the rover
+	// MaximumIntegrity is the full integrity of the rover, derived from the loadout
 	MaximumIntegrity int
 
 	// Charge is the amount of energy the rover has
 	Charge int
 
-	// MaximumCharge is the maximum charge able to be stored
+	// MaximumCharge is the maximum charge able to be stored, derived from the loadout
 	MaximumCharge int
 
 	// SailPosition is the current position of the sails
@@ -64,68 +79,137 @@ type Rover struct {
 	// Current number of ticks in this move, used for sailing speeds
 	MoveTicks int
 
+	// Crafting is the name of the recipe currently being crafted
+	Crafting string
+
+	// CraftTicks is the number of ticks left until crafting completes
+	CraftTicks int
+
 	// Logs Stores log of information
 	Logs []RoverLogEntry
 
+	// Heard holds the beacons in range at the last tick, so their messages are only logged when new
+	Heard []HeardBeacon
+
+	// RechargedDay is the day, counting from 1, of the last recharge logged from a solar station
+	RechargedDay int
+
 	// The account that owns this rover
 	Owner string
 }
 
-// DefaultRover returns a default rover object with default settings
+// DefaultRover returns a default rover object with the standard rules
 func DefaultRover() *Rover {
-	return &Rover{
-		Range:            10,
-		Integrity:        10,
-		MaximumIntegrity: 10,
-		Capacity:         10,
-		Charge:           10,
-		MaximumCharge:    10,
-		Bearing:          roveapi.Bearing_North,
-		SailPosition:     roveapi.SailPosition_SolarCharging,
-		Name:             GenerateRoverName(),
+	return NewRover(DefaultRules())
+}
+
+// NewRover returns a new rover object with the specifications given by the rules
+func NewRover(rules Rules) *Rover {
+	r := &Rover{
+		Loadout:      make(map[roveapi.ComponentSlot]Object),
+		Bearing:      roveapi.Bearing_North,
+		SailPosition: roveapi.SailPosition_SolarCharging,
+		Name:         GenerateRoverName(),
+	}
+	r.UpdateSpecifications(rules)
+	r.Integrity = r.MaximumIntegrity
+	r.Charge = r.MaximumCharge
+	return r
+}
+
+// UpdateSpecifications derives the rover specifications from the base values in the rules and the installed components
+// Integrity and charge are clamped to any new maximums
+func (r *Rover) UpdateSpecifications(rules Rules) {
+	r.Range = rules.BaseRange + r.Upgrades.Range
+	r.Capacity = rules.BaseCapacity + r.Upgrades.Capacity
+	r.MaximumIntegrity = rules.BaseMaximumIntegrity + r.Upgrades.MaximumIntegrity
+	r.MaximumCharge = rules.BaseMaximumCharge + r.Upgrades.MaximumCharge
+
+	for _, o := range r.Loadout {
+		if c, ok := FindComponent(o.Type); ok {
+			r.Range += c.Range
+			r.Capacity += c.Capacity
+			r.MaximumIntegrity += c.MaximumIntegrity
+			r.MaximumCharge += c.MaximumCharge
+		}
 	}
+
+	r.Integrity = maths.Min(r.Integrity, r.MaximumIntegrity)
+	r.Charge = maths.Min(r.Charge, r.MaximumCharge)
+}
+
+// keepLegacyUpgrades records any saved specifications beyond what the rules and loadout give as upgrades
+// Older versions upgraded the specifications directly, so these would otherwise be lost the next time they're derived
+func (r *Rover) keepLegacyUpgrades(rules Rules) {
+	saved := *r
+	r.Upgrades = RoverUpgrades{}
+	r.UpdateSpecifications(rules)
+	r.Upgrades = RoverUpgrades{
+		Range:            maths.Max(saved.Range-r.Range, 0),
+		Capacity:         maths.Max(saved.Capacity-r.Capacity, 0),
+		MaximumIntegrity: maths.Max(saved.MaximumIntegrity-r.MaximumIntegrity, 0),
+		MaximumCharge:    maths.Max(saved.MaximumCharge-r.MaximumCharge, 0),
+	}
+	r.Integrity, r.Charge = saved.Integrity, saved.Charge
+	r.UpdateSpecifications(rules)
+}
+
+// hasHeard returns whether the rover already heard a beacon's message at the last tick
+func (r *Rover) hasHeard(beacon HeardBeacon) bool {
+	for _, b := range r.Heard {
+		if b == beacon {
+			return true
+		}
+	}
+	return false
 }
 
 // AddLogEntryf adds an entry to the rovers log
 func (r *Rover) AddLogEntryf(format string, args ...interface{}) {
 	text := fmt.Sprintf(format, args...)
-	log.Printf("%s log entry: %s", r.Name, text)
 	r.Logs = append(r.Logs,
 		RoverLogEntry{
 			Time: time.Now(),
 			Text: text,
 		},
 	)
+}
 
-	// Limit the number of logs
-	if len(r.Logs) > maxLogEntries {
-		r.Logs = r.Logs[len(r.Logs)-maxLogEntries:]
+// TrimLogs drops the oldest log entries so no more than max remain
+func (r *Rover) TrimLogs(max int) {
+	if len(r.Logs) > max {
+		r.Logs = r.Logs[len(r.Logs)-max:]
 	}
 }
 
-var wordsFile = os.Getenv("WORDS_FILE")
 var roverWords []string
 
-// GenerateRoverName generates a new rover name
-func GenerateRoverName() string {
+// SetWordsFile loads the file of words to generate rover names from
+// Names fall back to unique strings if the file can't be read
+func SetWordsFile(path string) error {
+	roverWords = nil
 
-	// Try and load the rover words file
-	if len(roverWords) == 0 {
-		// Try and load the words file
-		if file, err := os.Open(wordsFile); err != nil {
-			log.Printf("Couldn't read words file [%s], running without words: %s\n", wordsFile, err)
-		} else {
-			defer file.Close()
-			scanner := bufio.NewScanner(file)
-			for scanner.Scan() {
-				roverWords = append(roverWords, scanner.Text())
-			}
-			if scanner.Err() != nil {
-				log.Printf("Failure during word file scan: %s\n", scanner.Err())
-			}
-		}
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("couldn't read words file [%s]: %w", path, err)
+	}
+	defer file.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		words = append(words, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failure during words file scan [%s]: %w", path, err)
 	}
 
+	roverWords = words
+	return nil
+}
+
+// GenerateRoverName generates a new rover name
+func GenerateRoverName() string {
 	// Assign a random name if we have words
 	if len(roverWords) > 0 {
 		// Loop until we find a unique name
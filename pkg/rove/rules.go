@@ -0,0 +1,110 @@
+package rove
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Rules holds the gameplay numbers a world is played with
+type Rules struct {
+	// Base specifications of a rover with no components installed
+	BaseRange            int `yaml:"base_range"`
+	BaseCapacity         int `yaml:"base_capacity"`
+	BaseMaximumIntegrity int `yaml:"base_maximum_integrity"`
+	BaseMaximumCharge    int `yaml:"base_maximum_charge"`
+
+	// MaxLogEntries is the number of log entries each rover keeps
+	MaxLogEntries int `yaml:"max_log_entries"`
+
+	// CommandCharge is the charge used by each command that needs energy
+	CommandCharge int `yaml:"command_charge"`
+
+	// RechargeRate is the charge gained each tick from the sun or a solar station
+	RechargeRate int `yaml:"recharge_rate"`
+
+	// CollisionDamage is the integrity lost when sailing into a blocking tile
+	CollisionDamage int `yaml:"collision_damage"`
+
+	// SalvageParts is the number of rover parts salvaged from a dormant rover
+	SalvageParts int `yaml:"salvage_parts"`
+
+	// RepairIntegrity is the integrity restored by each rover part used in a repair
+	RepairIntegrity int `yaml:"repair_integrity"`
+
+	// BroadcastLength is the maximum number of characters in a broadcast or beacon message
+	BroadcastLength int `yaml:"broadcast_length"`
+
+	// BeaconRange is the distance a beacon message reaches
+	BeaconRange int `yaml:"beacon_range"`
+
+	// CacheCapacity is the maximum total weight of objects a cache can store
+	CacheCapacity int `yaml:"cache_capacity"`
+
+	// SailTicks is the number of ticks a sailing rover takes to move one tile, indexed by how many
+	// eighths of a turn it's facing away from the wind, 0 means it can't move at all
+	SailTicks []int `yaml:"sail_ticks"`
+}
+
+// DefaultRules returns the standard rules
+func DefaultRules() Rules {
+	return Rules{
+		BaseRange:            10,
+		BaseCapacity:         10,
+		BaseMaximumIntegrity: 10,
+		BaseMaximumCharge:    10,
+		MaxLogEntries:        16,
+		CommandCharge:        1,
+		RechargeRate:         1,
+		CollisionDamage:      1,
+		SalvageParts:         5,
+		RepairIntegrity:      1,
+		BroadcastLength:      3,
+		BeaconRange:          5,
+		CacheCapacity:        20,
+
+		// Going with the wind is the base speed, a slight angle is a little faster, perpendicular is
+		// the fastest, 45 degrees into the wind is back to base speed, and directly into it is stopped
+		SailTicks: []int{4, 2, 1, 4, 0},
+	}
+}
+
+// LoadRules loads rules from a YAML file, anything the file doesn't set keeps the standard rule
+func LoadRules(path string) (Rules, error) {
+	rules := DefaultRules()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Rules{}, err
+	} else if err := yaml.UnmarshalStrict(b, &rules); err != nil {
+		return Rules{}, fmt.Errorf("failed to parse rules file %s: %s", path, err)
+	}
+
+	return rules, rules.Validate()
+}
+
+// Validate checks the rules make sense
+func (r Rules) Validate() error {
+	switch {
+	case r.BaseRange < 1 || r.BaseCapacity < 0 || r.BaseMaximumIntegrity < 1 || r.BaseMaximumCharge < 0:
+		return fmt.Errorf("%w: base rover specifications must be positive", ErrInvalidArgument)
+	case r.MaxLogEntries < 1:
+		return fmt.Errorf("%w: rovers must keep at least one log entry", ErrInvalidArgument)
+	case r.CommandCharge < 0 || r.RechargeRate < 0 || r.CollisionDamage < 0 || r.SalvageParts < 0:
+		return fmt.Errorf("%w: charges, damage and parts can't be negative", ErrInvalidArgument)
+	case r.RepairIntegrity < 1:
+		return fmt.Errorf("%w: repairs must restore some integrity", ErrInvalidArgument)
+	case r.BroadcastLength < 1 || r.BeaconRange < 0 || r.CacheCapacity < 0:
+		return fmt.Errorf("%w: broadcast length must be positive, and beacon range and cache capacity not negative", ErrInvalidArgument)
+	case len(r.SailTicks) != 5:
+		return fmt.Errorf("%w: sail ticks needs an entry for each of the 5 angles to the wind: %v", ErrInvalidArgument, r.SailTicks)
+	}
+
+	for _, t := range r.SailTicks {
+		if t < 0 {
+			return fmt.Errorf("%w: sail ticks can't be negative: %v", ErrInvalidArgument, r.SailTicks)
+		}
+	}
+	return nil
+}
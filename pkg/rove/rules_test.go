@@ -0,0 +1,134 @@
+package rove
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/mdiluz/rove/pkg/maths"
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadRules(t *testing.T) {
+	assert.NoError(t, DefaultRules().Validate())
+
+	// The example variants should all load
+	for _, variant := range []string{"hardcore", "casual"} {
+		_, err := LoadRules(path.Join("..", "..", "data", "rules", variant+".yaml"))
+		assert.NoError(t, err, variant)
+	}
+
+	tmp, err := ioutil.TempDir(os.TempDir(), "rove-rules-")
+	assert.NoError(t, err)
+	file := path.Join(tmp, "rules.yaml")
+
+	// Anything not set keeps the standard rule
+	assert.NoError(t, ioutil.WriteFile(file, []byte("command_charge: 3\nsail_ticks: [1, 1, 1, 1, 1]\n"), 0644))
+	rules, err := LoadRules(file)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rules.CommandCharge)
+	assert.Equal(t, []int{1, 1, 1, 1, 1}, rules.SailTicks)
+	assert.Equal(t, DefaultRules().SalvageParts, rules.SalvageParts)
+
+	// Unknown and invalid rules are rejected
+	assert.NoError(t, ioutil.WriteFile(file, []byte("comand_charge: 3\n"), 0644))
+	_, err = LoadRules(file)
+	assert.Error(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("sail_ticks: [1, 1]\n"), 0644))
+	_, err = LoadRules(file)
+	assert.Error(t, err)
+
+	_, err = LoadRules(path.Join(tmp, "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestWorld_Rules(t *testing.T) {
+	w := NewWorld(8)
+	w.Rules.BaseMaximumCharge = 20
+	w.Rules.CommandCharge = 3
+	w.Rules.BroadcastLength = 5
+	w.Rules.SalvageParts = 2
+	w.Rules.MaxLogEntries = 2
+
+	name, err := w.SpawnRover("")
+	assert.NoError(t, err)
+	r, err := w.GetRover(name)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, r.MaximumCharge)
+	assert.Equal(t, 20, r.Charge)
+
+	// Longer messages are allowed and each command uses the configured charge
+	assert.NoError(t, w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_broadcast, Data: []byte("HELLO")}))
+	w.Tick()
+	r, err = w.GetRover(name)
+	assert.NoError(t, err)
+	assert.Equal(t, 17, r.Charge)
+	assert.Contains(t, r.Logs[len(r.Logs)-1].Text, "HELLO")
+	assert.Len(t, r.Logs, 2)
+
+	assert.Error(t, w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_broadcast, Data: []byte("TOOLONG")}))
+
+	// Salvage yields the configured number of parts
+	w.Atlas.SetObject(r.Pos, Object{Type: roveapi.Object_RoverDormant})
+	assert.NoError(t, w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_salvage}))
+	w.Tick()
+	r, err = w.GetRover(name)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, r.Inventory.Count(roveapi.Object_RoverParts))
+
+	// Each part repairs the configured integrity, up to the maximum
+	w.Rules.RepairIntegrity = 3
+	w.Rovers[name].Integrity = 5
+	assert.NoError(t, w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_repair}))
+	w.Tick()
+	assert.Equal(t, 8, w.Rovers[name].Integrity)
+	assert.NoError(t, w.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_repair}))
+	w.Tick()
+	assert.Equal(t, w.Rovers[name].MaximumIntegrity, w.Rovers[name].Integrity)
+	r, err = w.GetRover(name)
+	assert.NoError(t, err)
+
+	// Sailing speeds come from the rules
+	w.Rules.SailTicks = []int{1, 1, 1, 1, 1}
+	w.Atlas.SetObject(r.Pos.Added(maths.Vector{Y: 1}), Object{Type: roveapi.Object_ObjectUnknown})
+	_, err = w.RoverToggle(name)
+	assert.NoError(t, err)
+	w.Wind = roveapi.Bearing_South
+	w.Tick()
+	moved, err := w.GetRover(name)
+	assert.NoError(t, err)
+	assert.Equal(t, r.Pos.Added(maths.Vector{Y: 1}), moved.Pos)
+}
+
+func TestWorld_SetRules(t *testing.T) {
+	world := NewWorld(4)
+	name, err := world.SpawnRover("")
+	assert.NoError(t, err)
+
+	// Snapshots with rules that would break ticks are rejected
+	world.Rules.SailTicks = nil
+	snapshot, err := world.Snapshot()
+	assert.NoError(t, err)
+	other := NewWorld(4)
+	assert.Error(t, other.Restore(snapshot))
+	assert.Error(t, world.SetRules(world.Rules))
+
+	// Rules missing from older saves keep their standard values
+	world.Rules = DefaultRules()
+	snapshot, err = world.Snapshot()
+	assert.NoError(t, err)
+	assert.True(t, bytes.Contains(snapshot, []byte(`"RepairIntegrity":1,`)))
+	snapshot = bytes.Replace(snapshot, []byte(`"RepairIntegrity":1,`), nil, 1)
+	assert.NoError(t, other.Restore(snapshot))
+	assert.Equal(t, DefaultRules().RepairIntegrity, other.Rules.RepairIntegrity)
+
+	// New rules apply to existing rovers straight away
+	rules := DefaultRules()
+	rules.BaseRange = 10
+	assert.NoError(t, world.SetRules(rules))
+	assert.Equal(t, 10, world.Rovers[name].Range)
+}
@@ -0,0 +1,77 @@
+package rove
+
+import (
+	"encoding/json"
+
+	"github.com/mdiluz/rove/proto/roveapi"
+)
+
+// Structure describes the state of a built structure, stored in the object data
+type Structure struct {
+	// Owner is the account that built the structure
+	Owner string
+
+	// Message is the message broadcast by a beacon
+	Message []byte
+
+	// Inventory holds the objects stored in a cache
+	Inventory Inventory
+}
+
+// blueprints are the recipes for structures, which are built instantly in place
+var blueprints = []Recipe{
+	{
+		Name: "beacon",
+		Ingredients: map[roveapi.Object]int{
+			roveapi.Object_AntennaBooster: 1,
+			roveapi.Object_RockSmall:      2,
+		},
+		Product: roveapi.Object_Beacon,
+	},
+	{
+		Name: "solar-station",
+		Ingredients: map[roveapi.Object]int{
+			roveapi.Object_SolarPanel: 2,
+			roveapi.Object_RockSmall:  2,
+		},
+		Product: roveapi.Object_SolarStation,
+	},
+	{
+		Name: "cache",
+		Ingredients: map[roveapi.Object]int{
+			roveapi.Object_HullPlate: 1,
+			roveapi.Object_RockSmall: 4,
+		},
+		Product: roveapi.Object_Cache,
+	},
+}
+
+// FindBlueprint returns the blueprint for a structure
+func FindBlueprint(t roveapi.Object) (Recipe, bool) {
+	for _, b := range blueprints {
+		if b.Product == t {
+			return b, true
+		}
+	}
+	return Recipe{}, false
+}
+
+// IsStructure checks if an object is a built structure
+func (o *Object) IsStructure() bool {
+	_, ok := FindBlueprint(o.Type)
+	return ok
+}
+
+// Structure unmarshals the structure state held in the object data
+func (o *Object) Structure() (s Structure, err error) {
+	if len(o.Data) > 0 {
+		err = json.Unmarshal(o.Data, &s)
+	}
+	return
+}
+
+// SetStructure marshals the structure state into the object data
+func (o *Object) SetStructure(s Structure) (err error) {
+	o.Data, err = json.Marshal(s)
+	return
+}
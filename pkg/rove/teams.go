@@ -0,0 +1,215 @@
+package rove
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/mdiluz/rove/pkg/accounts"
+	"github.com/mdiluz/rove/pkg/maths"
+	"github.com/mdiluz/rove/proto/roveapi"
+)
+
+const (
+	// maxMapRange is the largest range that can be requested from the explored map
+	maxMapRange = 32
+
+	// maxTeamMessageLength is the longest message that can be sent to a team
+	maxTeamMessageLength = 64
+)
+
+// exploredChunkSize is the width of the squares of positions an explored map stores together
+const exploredChunkSize = 16
+
+// exploredChunk is a bitset of the positions seen within one square, a bit for each position in row order
+type exploredChunk [exploredChunkSize * exploredChunkSize / 64]uint64
+
+// ExploredMap is the set of positions an account has seen, as a bitset for each square with anything seen in it
+// It grows with the area explored rather than with how often it's seen
+type ExploredMap map[maths.Vector]*exploredChunk
+
+// locate returns the square holding a position and the bit for the position within it
+func (e ExploredMap) locate(p maths.Vector) (maths.Vector, int) {
+	bit := maths.Pmod(p.X, exploredChunkSize) + maths.Pmod(p.Y, exploredChunkSize)*exploredChunkSize
+	return p.DividedFloor(exploredChunkSize), bit
+}
+
+// Mark marks a position as seen
+func (e ExploredMap) Mark(p maths.Vector) {
+	key, bit := e.locate(p)
+	c, ok := e[key]
+	if !ok {
+		c = &exploredChunk{}
+		e[key] = c
+	}
+	c[bit/64] |= 1 << uint(bit%64)
+}
+
+// Seen returns whether a position has been seen
+func (e ExploredMap) Seen(p maths.Vector) bool {
+	key, bit := e.locate(p)
+	c, ok := e[key]
+	return ok && c[bit/64]&(1<<uint(bit%64)) != 0
+}
+
+// exploredEntry is how an explored map is saved, a square and its bitset
+// Older saves listed every position instead, with only X and Y set
+type exploredEntry struct {
+	X, Y  int            `json:",omitempty"`
+	Chunk *maths.Vector  `json:",omitempty"`
+	Bits  *exploredChunk `json:",omitempty"`
+}
+
+// MarshalJSON saves the explored map as a list of squares and their bitsets
+func (e ExploredMap) MarshalJSON() ([]byte, error) {
+	entries := make([]exploredEntry, 0, len(e))
+	for key, c := range e {
+		key := key
+		entries = append(entries, exploredEntry{Chunk: &key, Bits: c})
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON loads the explored map, also accepting the older list of positions
+func (e *ExploredMap) UnmarshalJSON(b []byte) error {
+	var entries []exploredEntry
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return err
+	}
+
+	*e = make(ExploredMap)
+	for _, entry := range entries {
+		if entry.Chunk != nil && entry.Bits != nil {
+			(*e)[*entry.Chunk] = entry.Bits
+		} else {
+			e.Mark(maths.Vector{X: entry.X, Y: entry.Y})
+		}
+	}
+	return nil
+}
+
+// explore marks everything within range of each owned rover as explored by its owner
+func (w *World) explore() {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	for _, r := range w.Rovers {
+		if len(r.Owner) == 0 {
+			continue
+		}
+
+		explored, ok := w.Explored[r.Owner]
+		if !ok {
+			explored = make(ExploredMap)
+			w.Explored[r.Owner] = explored
+		}
+
+		for j := r.Pos.Y - r.Range; j <= r.Pos.Y+r.Range; j++ {
+			for i := r.Pos.X - r.Range; i <= r.Pos.X+r.Range; i++ {
+				explored.Mark(maths.Vector{X: i, Y: j})
+			}
+		}
+	}
+}
+
+// ForgetExplored removes everything an account has explored
+func (w *World) ForgetExplored(account string) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	delete(w.Explored, account)
+}
+
+// MapFromRover returns the tiles around a rover explored by its owner's team, in the same layout as the radar
+// Unexplored tiles are left unknown
+func (w *World) MapFromRover(rover string, rng int) (tiles []roveapi.Tile, objs []roveapi.Object, err error) {
+	if rng <= 0 || rng > maxMapRange {
+		return nil, nil, fmt.Errorf("%w: map range must be between 1 and %d: %d", ErrInvalidArgument, maxMapRange, rng)
+	}
+
+	w.worldMutex.RLock()
+	defer w.worldMutex.RUnlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", ErrRoverNotFound, rover)
+	}
+
+	// Gather the explored maps of the whole team
+	var explored []ExploredMap
+	for _, account := range w.Teams.Teammates(r.Owner) {
+		if e, ok := w.Explored[account]; ok {
+			explored = append(explored, e)
+		}
+	}
+
+	span := (rng * 2) + 1
+	tiles = make([]roveapi.Tile, span*span)
+	objs = make([]roveapi.Object, span*span)
+	for j := 0; j < span; j++ {
+		for i := 0; i < span; i++ {
+			q := maths.Vector{X: r.Pos.X - rng + i, Y: r.Pos.Y - rng + j}
+			for _, e := range explored {
+				if e.Seen(q) {
+					tile, obj := w.Atlas.QueryPosition(q)
+					tiles[i+j*span] = tile
+					objs[i+j*span] = obj.Type
+					break
+				}
+			}
+		}
+	}
+
+	return tiles, objs, nil
+}
+
+// TeamRovers returns copies of all the rovers owned by the account's team, sorted by name
+func (w *World) TeamRovers(account string) []Rover {
+	w.worldMutex.RLock()
+	defer w.worldMutex.RUnlock()
+
+	var rovers []Rover
+	for _, member := range w.Teams.Teammates(account) {
+		for _, name := range w.fleet(member) {
+			rovers = append(rovers, *w.Rovers[name])
+		}
+	}
+	sort.Slice(rovers, func(i, j int) bool {
+		return rovers[i].Name < rovers[j].Name
+	})
+	return rovers
+}
+
+// TeamBroadcast sends a message to every rover owned by the account's team
+func (w *World) TeamBroadcast(account string, message string) error {
+	if len(w.Teams.TeamOf(account)) == 0 {
+		return fmt.Errorf("%w: %s", accounts.ErrNotInTeam, account)
+	} else if err := validateTeamMessage(message); err != nil {
+		return err
+	}
+
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	for _, member := range w.Teams.Teammates(account) {
+		for _, name := range w.fleet(member) {
+			w.Rovers[name].AddLogEntryf("team message from %s: %s", account, message)
+		}
+	}
+	return nil
+}
+
+// validateTeamMessage checks a team message is printable ASCII and not too long
+func validateTeamMessage(message string) error {
+	if len(message) == 0 {
+		return fmt.Errorf("%w: empty team message", ErrInvalidArgument)
+	} else if len(message) > maxTeamMessageLength {
+		return fmt.Errorf("%w: too many characters in team message (limit %d): %d", ErrInvalidArgument, maxTeamMessageLength, len(message))
+	}
+	for _, b := range []byte(message) {
+		if b < 32 || b > 126 {
+			return fmt.Errorf("%w: invalid team message character: %q", ErrInvalidArgument, b)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package rove
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mdiluz/rove/pkg/maths"
+	"github.com/mdiluz/rove/proto/roveapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorld_ExploredMap(t *testing.T) {
+	w := NewWorld(8)
+	a, err := w.SpawnRover("a")
+	assert.NoError(t, err)
+	b, err := w.SpawnRover("b")
+	assert.NoError(t, err)
+
+	// Move the second rover well away from the first
+	assert.NoError(t, w.WarpRover(b, maths.Vector{X: 100, Y: 100}))
+	w.Tick()
+
+	// Without a team each account only sees their own exploration
+	tiles, _, err := w.MapFromRover(b, 1)
+	assert.NoError(t, err)
+	assert.NotContains(t, tiles, roveapi.Tile_TileUnknown)
+
+	assert.NoError(t, w.WarpRover(a, maths.Vector{X: 100, Y: 101}))
+	tiles, _, err = w.MapFromRover(a, 1)
+	assert.NoError(t, err)
+	assert.Contains(t, tiles, roveapi.Tile_TileUnknown)
+
+	// Once in a team the exploration is shared
+	assert.NoError(t, w.Teams.CreateTeam("team", "a"))
+	assert.NoError(t, w.Teams.JoinTeam("team", "b"))
+	tiles, _, err = w.MapFromRover(a, 1)
+	assert.NoError(t, err)
+	assert.NotContains(t, tiles, roveapi.Tile_TileUnknown)
+
+	// Invalid ranges are rejected
+	_, _, err = w.MapFromRover(a, 0)
+	assert.Error(t, err)
+	_, _, err = w.MapFromRover(a, maxMapRange+1)
+	assert.Error(t, err)
+
+	// And the explored map should survive a round trip
+	data, err := json.Marshal(w.Explored)
+	assert.NoError(t, err)
+	var loaded map[string]ExploredMap
+	assert.NoError(t, json.Unmarshal(data, &loaded))
+	assert.Equal(t, w.Explored, loaded)
+}
+
+func TestExploredMap_Bounded(t *testing.T) {
+	w := NewWorld(8)
+	a, err := w.SpawnRover("a")
+	assert.NoError(t, err)
+	rover := w.Rovers[a]
+
+	// A rover sweeping across the world only adds the squares it sees
+	for x := -40; x < 40; x++ {
+		rover.Pos = maths.Vector{X: x, Y: -40}
+		w.explore()
+	}
+	span := 2*rover.Range + 1
+	squares := ((80+span)/exploredChunkSize + 2) * (span/exploredChunkSize + 2)
+	assert.LessOrEqual(t, len(w.Explored["a"]), squares)
+
+	// And seeing the same area again doesn't grow it, or what's saved
+	data, err := json.Marshal(w.Explored["a"])
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		w.explore()
+	}
+	again, err := json.Marshal(w.Explored["a"])
+	assert.NoError(t, err)
+	assert.Len(t, again, len(data))
+
+	// Positions either side of the origin are kept apart
+	e := make(ExploredMap)
+	e.Mark(maths.Vector{X: -1, Y: -1})
+	assert.True(t, e.Seen(maths.Vector{X: -1, Y: -1}))
+	assert.False(t, e.Seen(maths.Vector{X: 0, Y: 0}))
+	assert.False(t, e.Seen(maths.Vector{X: -1, Y: -2}))
+	assert.False(t, e.Seen(maths.Vector{X: exploredChunkSize - 1, Y: exploredChunkSize - 1}))
+
+	// Older saves listing every position still load
+	var loaded ExploredMap
+	assert.NoError(t, json.Unmarshal([]byte(`[{"X":-1,"Y":-1},{"X":3,"Y":4}]`), &loaded))
+	assert.True(t, loaded.Seen(maths.Vector{X: -1, Y: -1}))
+	assert.True(t, loaded.Seen(maths.Vector{X: 3, Y: 4}))
+	assert.False(t, loaded.Seen(maths.Vector{X: 4, Y: 3}))
+}
+
+func TestWorld_TeamBroadcast(t *testing.T) {
+	w := NewWorld(8)
+	a, err := w.SpawnRover("a")
+	assert.NoError(t, err)
+	b, err := w.SpawnRover("b")
+	assert.NoError(t, err)
+	c, err := w.SpawnRover("c")
+	assert.NoError(t, err)
+
+	// No team to message yet
+	assert.Error(t, w.TeamBroadcast("a", "hello"))
+
+	assert.NoError(t, w.Teams.CreateTeam("team", "a"))
+	assert.NoError(t, w.Teams.JoinTeam("team", "b"))
+
+	assert.Error(t, w.TeamBroadcast("a", ""))
+	assert.Error(t, w.TeamBroadcast("a", "bad\nmessage"))
+	assert.NoError(t, w.TeamBroadcast("a", "hello team"))
+
+	for _, name := range []string{a, b} {
+		r, err := w.GetRover(name)
+		assert.NoError(t, err)
+		assert.Contains(t, r.Logs[len(r.Logs)-1].Text, "hello team")
+	}
+	r, err := w.GetRover(c)
+	assert.NoError(t, err)
+	assert.NotContains(t, r.Logs[len(r.Logs)-1].Text, "hello team")
+
+	// Team rovers include everyone in the team
+	rovers := w.TeamRovers("b")
+	assert.Len(t, rovers, 2)
+}
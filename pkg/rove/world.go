@@ -3,21 +3,23 @@ package rove
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"math/rand"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/mdiluz/rove/pkg/accounts"
+	"github.com/mdiluz/rove/pkg/logging"
 	"github.com/mdiluz/rove/pkg/maths"
 	"github.com/mdiluz/rove/proto/roveapi"
 )
 
 const (
-	// ticksPerNormalMove defines the number of ticks it should take for a "normal" speed move
-	ticksPerNormalMove = 4
+	// maxAtlasRange is the largest range that can be queried from the atlas in one go
+	maxAtlasRange = 128
 
-	// upgradeCost is the cost in rover parts needed to upgrade a rover specification
-	upgradeCost = 5
+	// maxSpectateSize is the most samples along each side of a spectator view
+	maxSpectateSize = maxAtlasRange*2 + 1
 )
 
 // CommandStream is a list of commands to execute in order
@@ -26,9 +28,18 @@ type CommandStream []*roveapi.Command
 // World describes a self contained universe and everything in it
 type World struct {
 
+	// Version is the version of the world format, older worlds are migrated when loaded
+	Version int
+
 	// TicksPerDay is the amount of ticks in a single day
 	TicksPerDay int
 
+	// Rules holds the gameplay numbers consulted by all the commands
+	Rules Rules
+
+	// Recipes holds everything rovers can craft
+	Recipes Recipes
+
 	// Current number of ticks from the start
 	CurrentTicks int
 
@@ -44,35 +55,100 @@ type World struct {
 	// Commands is the set of currently executing command streams per rover
 	CommandQueue map[string]CommandStream
 
-	// Accountant
+	// Structures holds the positions of all structures built in the atlas
+	Structures []maths.Vector
+
+	// FleetCap is the maximum number of live rovers an account can own, 0 means no limit
+	FleetCap int
+
+	// Accountant stores the accounts, it guards its own state so needs no world lock
 	Accountant accounts.Accountant
 
+	// Teams stores the team memberships, it guards its own state so needs no world lock
+	Teams *accounts.TeamRegistry
+
+	// Explored is an account->map of everywhere each account's rovers have seen
+	Explored map[string]ExploredMap
+
+	// log records what happens in the world
+	log *logging.Logger
+
 	// Mutex to lock around all world operations
 	worldMutex sync.RWMutex
 	// Mutex to lock around command operations
 	cmdMutex sync.RWMutex
 }
 
+// worldVersion is the current version of the world format
+// Version 1 stores rover upgrades separately from the specifications derived from the loadout
+const worldVersion = 1
+
 // NewWorld creates a new world object
 func NewWorld(chunkSize int) *World {
-	return &World{
+	return NewSeededWorld(chunkSize, DefaultSeed)
+}
+
+// NewSeededWorld creates a new world object generated from a seed
+func NewSeededWorld(chunkSize int, seed int64) *World {
+	w := &World{
+		Version:      worldVersion,
 		Rovers:       make(map[string]*Rover),
 		CommandQueue: make(map[string]CommandStream),
-		Atlas:        NewChunkAtlas(chunkSize),
+		Atlas:        NewSeededChunkAtlas(chunkSize, seed),
 		TicksPerDay:  24,
+		Rules:        DefaultRules(),
+		Recipes:      DefaultRecipes(),
 		CurrentTicks: 0,
 		Accountant:   accounts.NewSimpleAccountant(),
+		Teams:        accounts.NewTeamRegistry(),
+		Explored:     make(map[string]ExploredMap),
 		Wind:         roveapi.Bearing_North,
 	}
+	w.SetLogger(logging.Default().Named("world"))
+	return w
+}
+
+// atlasLogger is implemented by atlases that log
+type atlasLogger interface {
+	setLogger(l *logging.Logger)
+}
+
+// SetLogger sets the logger for the world and its atlas
+func (w *World) SetLogger(l *logging.Logger) {
+	w.log = l
+	if a, ok := w.Atlas.(atlasLogger); ok {
+		a.setLogger(l)
+	}
 }
 
-// SpawnRover adds an rover to the game (without lock)
+// SpawnRover adds a rover to the game for an account, up to the fleet cap
 func (w *World) SpawnRover(account string) (string, error) {
 	w.worldMutex.Lock()
 	defer w.worldMutex.Unlock()
 
+	return w.spawnRover(account)
+}
+
+// EnsureFleet spawns a rover for an account with no rovers, returning its name, or nothing if the fleet isn't empty
+// The check and spawn happen under one lock, so concurrent callers can't both spawn
+func (w *World) EnsureFleet(account string) (string, error) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	if len(w.fleet(account)) > 0 {
+		return "", nil
+	}
+	return w.spawnRover(account)
+}
+
+// spawnRover adds a rover to the game for an account, up to the fleet cap (without lock)
+func (w *World) spawnRover(account string) (string, error) {
+	if len(account) > 0 && w.FleetCap > 0 && len(w.fleet(account)) >= w.FleetCap {
+		return "", fmt.Errorf("%w: %s already has %d rovers", ErrFleetFull, account, w.FleetCap)
+	}
+
 	// Initialise the rover
-	rover := DefaultRover()
+	rover := NewRover(w.Rules)
 
 	// Assign the owner
 	rover.Owner = account
@@ -101,13 +177,61 @@ func (w *World) SpawnRover(account string) (string, error) {
 	// Append the rover to the list
 	w.Rovers[rover.Name] = rover
 
-	var err error
-	// Only assign if we've been given an account
-	if len(account) > 0 {
-		err = w.Accountant.AssignData(account, "rover", rover.Name)
+	return rover.Name, nil
+}
+
+// Fleet returns the names of all live rovers owned by an account, sorted by name
+func (w *World) Fleet(account string) []string {
+	w.worldMutex.RLock()
+	defer w.worldMutex.RUnlock()
+
+	return w.fleet(account)
+}
+
+// fleet returns the sorted names of all rovers owned by an account (without lock)
+func (w *World) fleet(account string) (names []string) {
+	for name, r := range w.Rovers {
+		if r.Owner == account {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return
+}
+
+// WorldStats summarises the world for monitoring
+type WorldStats struct {
+	// LiveRovers and DormantRovers count the rovers in play and those left dormant in the atlas
+	LiveRovers    int
+	DormantRovers int
+
+	// Atlas summarises the atlas
+	Atlas AtlasStats
+
+	// QueuedCommands is the total number of queued commands, and MaxQueueDepth the most queued for any one rover
+	QueuedCommands int
+	MaxQueueDepth  int
+}
+
+// Stats summarises the world
+func (w *World) Stats() WorldStats {
+	// Locked in the same order as Tick
+	w.cmdMutex.RLock()
+	defer w.cmdMutex.RUnlock()
+	w.worldMutex.RLock()
+	defer w.worldMutex.RUnlock()
+
+	stats := WorldStats{
+		LiveRovers: len(w.Rovers),
+		Atlas:      w.Atlas.Stats(),
 	}
+	stats.DormantRovers = stats.Atlas.Objects[roveapi.Object_RoverDormant]
 
-	return rover.Name, err
+	for _, q := range w.CommandQueue {
+		stats.QueuedCommands += len(q)
+		stats.MaxQueueDepth = maths.Max(stats.MaxQueueDepth, len(q))
+	}
+	return stats
 }
 
 // GetRover gets a specific rover by name
@@ -117,7 +241,7 @@ func (w *World) GetRover(rover string) (Rover, error) {
 
 	i, ok := w.Rovers[rover]
 	if !ok {
-		return Rover{}, fmt.Errorf("Failed to find rover with name: %s", rover)
+		return Rover{}, fmt.Errorf("%w: %s", ErrRoverNotFound, rover)
 	}
 	return *i, nil
 }
@@ -129,7 +253,7 @@ func (w *World) RoverRecharge(rover string) (int, error) {
 
 	i, ok := w.Rovers[rover]
 	if !ok {
-		return 0, fmt.Errorf("Failed to find rover with name: %s", rover)
+		return 0, fmt.Errorf("%w: %s", ErrRoverNotFound, rover)
 	}
 
 	// We can only recharge during the day
@@ -137,9 +261,9 @@ func (w *World) RoverRecharge(rover string) (int, error) {
 		return i.Charge, nil
 	}
 
-	// Add one charge
+	// Add charge up to the maximum
 	if i.Charge < i.MaximumCharge {
-		i.Charge++
+		i.Charge = maths.Min(i.Charge+w.Rules.RechargeRate, i.MaximumCharge)
 		i.AddLogEntryf("recharged to %d", i.Charge)
 	}
 
@@ -153,14 +277,14 @@ func (w *World) RoverBroadcast(rover string, message []byte) (err error) {
 
 	i, ok := w.Rovers[rover]
 	if !ok {
-		return fmt.Errorf("Failed to find rover with name: %s", rover)
+		return fmt.Errorf("%w: %s", ErrRoverNotFound, rover)
 	}
 
 	// Use up a charge as needed, if available
-	if i.Charge == 0 {
+	if i.Charge < w.Rules.CommandCharge {
 		return
 	}
-	i.Charge--
+	i.Charge -= w.Rules.CommandCharge
 
 	// Check all rovers
 	for r, rover := range w.Rovers {
@@ -186,13 +310,20 @@ func (w *World) DestroyRover(rover string) error {
 
 	r, ok := w.Rovers[rover]
 	if !ok {
-		return fmt.Errorf("no rover matching id")
+		return ErrRoverNotFound
+	}
+
+	// Find somewhere to leave the dormant rover before touching anything
+	pos, err := w.dormantPosition(r.Pos)
+	if err != nil {
+		return err
 	}
 
 	// Remove this rover from tracked rovers
 	delete(w.Rovers, rover)
 
 	r.Owner = ""
+	r.Pos = pos
 	r.AddLogEntryf("rover destroyed")
 
 	// Marshal the rover data
@@ -207,6 +338,23 @@ func (w *World) DestroyRover(rover string) error {
 	return nil
 }
 
+// dormantPosition finds a free tile at or next to a position to leave a dormant rover on (without lock)
+// Structures and other objects are never overwritten
+func (w *World) dormantPosition(pos maths.Vector) (maths.Vector, error) {
+	if _, obj := w.Atlas.QueryPosition(pos); obj.Type == roveapi.Object_ObjectUnknown {
+		return pos, nil
+	}
+
+	for b := roveapi.Bearing_North; b <= roveapi.Bearing_NorthWest; b++ {
+		adjacent := pos.Added(maths.BearingToVector(b))
+		if _, obj := w.Atlas.QueryPosition(adjacent); obj.Type == roveapi.Object_ObjectUnknown {
+			return adjacent, nil
+		}
+	}
+
+	return pos, fmt.Errorf("%w: nowhere to leave a dormant rover near %+v", ErrNoSpace, pos)
+}
+
 // RoverPosition returns the position of the rover
 func (w *World) RoverPosition(rover string) (maths.Vector, error) {
 	w.worldMutex.RLock()
@@ -214,7 +362,7 @@ func (w *World) RoverPosition(rover string) (maths.Vector, error) {
 
 	i, ok := w.Rovers[rover]
 	if !ok {
-		return maths.Vector{}, fmt.Errorf("no rover matching id")
+		return maths.Vector{}, ErrRoverNotFound
 	}
 	return i.Pos, nil
 }
@@ -226,7 +374,7 @@ func (w *World) SetRoverPosition(rover string, pos maths.Vector) error {
 
 	i, ok := w.Rovers[rover]
 	if !ok {
-		return fmt.Errorf("no rover matching id")
+		return ErrRoverNotFound
 	}
 
 	i.Pos = pos
@@ -234,13 +382,13 @@ func (w *World) SetRoverPosition(rover string, pos maths.Vector) error {
 }
 
 // RoverInventory returns the inventory of a requested rover
-func (w *World) RoverInventory(rover string) ([]Object, error) {
+func (w *World) RoverInventory(rover string) (Inventory, error) {
 	w.worldMutex.RLock()
 	defer w.worldMutex.RUnlock()
 
 	i, ok := w.Rovers[rover]
 	if !ok {
-		return nil, fmt.Errorf("no rover matching id")
+		return nil, ErrRoverNotFound
 	}
 	return i.Inventory, nil
 }
@@ -252,7 +400,7 @@ func (w *World) WarpRover(rover string, pos maths.Vector) error {
 
 	i, ok := w.Rovers[rover]
 	if !ok {
-		return fmt.Errorf("no rover matching id")
+		return ErrRoverNotFound
 	}
 	// Nothing to do if these positions match
 	if i.Pos == pos {
@@ -262,13 +410,65 @@ func (w *World) WarpRover(rover string, pos maths.Vector) error {
 	// Check the tile is not blocked
 	_, obj := w.Atlas.QueryPosition(pos)
 	if obj.IsBlocking() {
-		return fmt.Errorf("can't warp rover to occupied tile, check before warping")
+		return fmt.Errorf("%w: can't warp rover to occupied tile, check before warping", ErrInvalidArgument)
 	}
 
 	i.Pos = pos
 	return nil
 }
 
+// SetRoverStats sets the integrity and charge of a rover, nil values are left unchanged
+// Values are clamped between zero and the rover's maximums
+func (w *World) SetRoverStats(rover string, integrity *int, charge *int) (Rover, error) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	i, ok := w.Rovers[rover]
+	if !ok {
+		return Rover{}, ErrRoverNotFound
+	}
+
+	if integrity != nil {
+		i.Integrity = maths.Max(0, maths.Min(*integrity, i.MaximumIntegrity))
+	}
+	if charge != nil {
+		i.Charge = maths.Max(0, maths.Min(*charge, i.MaximumCharge))
+	}
+	return *i, nil
+}
+
+// ListRovers returns copies of all live rovers, or only those owned by an account if one is given, sorted by name
+func (w *World) ListRovers(account string) []Rover {
+	w.worldMutex.RLock()
+	defer w.worldMutex.RUnlock()
+
+	var rovers []Rover
+	for _, r := range w.Rovers {
+		if len(account) == 0 || r.Owner == account {
+			rovers = append(rovers, *r)
+		}
+	}
+	sort.Slice(rovers, func(i, j int) bool {
+		return rovers[i].Name < rovers[j].Name
+	})
+	return rovers
+}
+
+// SetWind sets the current wind direction
+func (w *World) SetWind(b roveapi.Bearing) error {
+	if b == roveapi.Bearing_BearingUnknown {
+		return fmt.Errorf("%w: wind must have a bearing", ErrInvalidArgument)
+	} else if _, ok := roveapi.Bearing_name[int32(b)]; !ok {
+		return fmt.Errorf("%w: unknown bearing %d", ErrInvalidArgument, b)
+	}
+
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	w.Wind = b
+	return nil
+}
+
 // TryMoveRover attempts to move a rover in a specific direction
 func (w *World) TryMoveRover(rover string, b roveapi.Bearing) (maths.Vector, error) {
 	w.worldMutex.Lock()
@@ -276,7 +476,7 @@ func (w *World) TryMoveRover(rover string, b roveapi.Bearing) (maths.Vector, err
 
 	i, ok := w.Rovers[rover]
 	if !ok {
-		return maths.Vector{}, fmt.Errorf("no rover matching id")
+		return maths.Vector{}, ErrRoverNotFound
 	}
 
 	// Try the new move position
@@ -291,7 +491,7 @@ func (w *World) TryMoveRover(rover string, b roveapi.Bearing) (maths.Vector, err
 	} else {
 		// If it is a blocking tile, reduce the rover integrity
 		i.AddLogEntryf("tried to move %s to %+v", b.String(), newPos)
-		i.Integrity = i.Integrity - 1
+		i.Integrity -= w.Rules.CollisionDamage
 		i.AddLogEntryf("had a collision, new integrity %d", i.Integrity)
 	}
 
@@ -305,33 +505,85 @@ func (w *World) RoverStash(rover string) (roveapi.Object, error) {
 
 	r, ok := w.Rovers[rover]
 	if !ok {
-		return roveapi.Object_ObjectUnknown, fmt.Errorf("no rover matching id")
+		return roveapi.Object_ObjectUnknown, ErrRoverNotFound
 	}
 
 	// Can't pick up when full
-	if len(r.Inventory) >= r.Capacity {
+	if r.Inventory.Weight() >= r.Capacity {
 		r.AddLogEntryf("tried to stash object but inventory was full")
 		return roveapi.Object_ObjectUnknown, nil
 	}
 
 	// Ensure the rover has energy
-	if r.Charge <= 0 {
+	if r.Charge < w.Rules.CommandCharge {
 		r.AddLogEntryf("tried to stash object but had no charge")
 		return roveapi.Object_ObjectUnknown, nil
 	}
-	r.Charge--
+	r.Charge -= w.Rules.CommandCharge
 
 	_, obj := w.Atlas.QueryPosition(r.Pos)
 	if !obj.IsStashable() {
 		return roveapi.Object_ObjectUnknown, nil
 	}
 
-	r.AddLogEntryf("stashed %c", obj.Type)
-	r.Inventory = append(r.Inventory, obj)
+	// Can't pick up something that would go over capacity
+	if r.Inventory.Weight()+obj.Weight() > r.Capacity {
+		r.AddLogEntryf("tried to stash %s but it was too heavy", obj.Type)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	r.AddLogEntryf("stashed %s", obj.Type)
+	r.Inventory.Add(obj, 1)
 	w.Atlas.SetObject(r.Pos, Object{Type: roveapi.Object_ObjectUnknown})
 	return obj.Type, nil
 }
 
+// RoverDrop will drop an inventory object onto the current or an adjacent tile
+// The object is chosen by type if given, otherwise by inventory index
+func (w *World) RoverDrop(rover string, object roveapi.Object, index int, bearing roveapi.Bearing) (roveapi.Object, error) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return roveapi.Object_ObjectUnknown, ErrRoverNotFound
+	}
+
+	// Find the inventory stack to drop from
+	i := -1
+	if object != roveapi.Object_ObjectUnknown {
+		i = r.Inventory.Find(object)
+	} else if index >= 0 && index < len(r.Inventory) {
+		i = index
+	}
+	if i < 0 {
+		r.AddLogEntryf("tried to drop object but had no matching object")
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	// Ensure the rover has energy
+	if r.Charge < w.Rules.CommandCharge {
+		r.AddLogEntryf("tried to drop object but had no charge")
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	// Only one object can occupy a tile, which also keeps us off blocking tiles
+	pos := r.Pos.Added(maths.BearingToVector(bearing))
+	_, obj := w.Atlas.QueryPosition(pos)
+	if obj.Type != roveapi.Object_ObjectUnknown {
+		r.AddLogEntryf("tried to drop object but %+v was occupied", pos)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+	r.Charge -= w.Rules.CommandCharge
+
+	// Take a single object from the stack
+	dropped, _ := r.Inventory.Take(i)
+
+	r.AddLogEntryf("dropped %s at %+v", dropped.Type, pos)
+	w.Atlas.SetObject(pos, dropped)
+	return dropped.Type, nil
+}
+
 // RoverSalvage will salvage a rover for parts
 func (w *World) RoverSalvage(rover string) (roveapi.Object, error) {
 	w.worldMutex.Lock()
@@ -339,21 +591,21 @@ func (w *World) RoverSalvage(rover string) (roveapi.Object, error) {
 
 	r, ok := w.Rovers[rover]
 	if !ok {
-		return roveapi.Object_ObjectUnknown, fmt.Errorf("no rover matching id")
+		return roveapi.Object_ObjectUnknown, ErrRoverNotFound
 	}
 
 	// Can't pick up when full
-	if len(r.Inventory) >= r.Capacity {
+	if r.Inventory.Weight() >= r.Capacity {
 		r.AddLogEntryf("tried to salvage dormant rover but inventory was full")
 		return roveapi.Object_ObjectUnknown, nil
 	}
 
 	// Ensure the rover has energy
-	if r.Charge <= 0 {
+	if r.Charge < w.Rules.CommandCharge {
 		r.AddLogEntryf("tried to salvage dormant rover but had no charge")
 		return roveapi.Object_ObjectUnknown, nil
 	}
-	r.Charge--
+	r.Charge -= w.Rules.CommandCharge
 
 	_, obj := w.Atlas.QueryPosition(r.Pos)
 	if obj.Type != roveapi.Object_RoverDormant {
@@ -362,11 +614,12 @@ func (w *World) RoverSalvage(rover string) (roveapi.Object, error) {
 	}
 
 	r.AddLogEntryf("salvaged dormant rover")
-	for i := 0; i < 5; i++ {
-		if len(r.Inventory) == r.Capacity {
+	parts := Object{Type: roveapi.Object_RoverParts}
+	for i := 0; i < w.Rules.SalvageParts; i++ {
+		if r.Inventory.Weight()+parts.Weight() > r.Capacity {
 			break
 		}
-		r.Inventory = append(r.Inventory, Object{Type: roveapi.Object_RoverParts})
+		r.Inventory.Add(parts, 1)
 	}
 	w.Atlas.SetObject(r.Pos, Object{Type: roveapi.Object_ObjectUnknown})
 	return obj.Type, nil
@@ -379,7 +632,7 @@ func (w *World) RoverTransfer(rover string) (string, error) {
 
 	oldRover, ok := w.Rovers[rover]
 	if !ok {
-		return "", fmt.Errorf("no rover matching id")
+		return "", ErrRoverNotFound
 	}
 
 	_, obj := w.Atlas.QueryPosition(oldRover.Pos)
@@ -400,14 +653,17 @@ func (w *World) RoverTransfer(rover string) (string, error) {
 	newRover.AddLogEntryf("transferred from rover %s", oldRover.Name)
 
 	// Transfer the ownership
-	err = w.Accountant.AssignData(oldRover.Owner, "rover", newRover.Name)
+	newRover.Owner = oldRover.Owner
+	oldRover.Owner = ""
+
+	// Pick up the dormant rover, then leave the old rover in its place
+	w.Atlas.SetObject(oldRover.Pos, Object{Type: roveapi.Object_ObjectUnknown})
+	pos, err := w.dormantPosition(oldRover.Pos)
 	if err != nil {
 		return "", err
 	}
-	newRover.Owner = oldRover.Owner
-	oldRover.Owner = ""
+	oldRover.Pos = pos
 
-	// Place the old rover in the world
 	oldRoverData, err := json.Marshal(oldRover)
 	if err != nil {
 		return "", err
@@ -425,6 +681,47 @@ func (w *World) RoverTransfer(rover string) (string, error) {
 	return newRover.Name, nil
 }
 
+// RoverClaim will claim a dormant rover into the fleet of the rover's owner
+func (w *World) RoverClaim(rover string) (string, error) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return "", ErrRoverNotFound
+	}
+
+	_, obj := w.Atlas.QueryPosition(r.Pos)
+	if obj.Type != roveapi.Object_RoverDormant {
+		r.AddLogEntryf("tried to claim dormant rover but found no rover")
+		return "", nil
+	}
+
+	// Respect the fleet cap
+	if w.FleetCap > 0 && len(w.fleet(r.Owner)) >= w.FleetCap {
+		r.AddLogEntryf("tried to claim dormant rover but fleet was full")
+		return "", nil
+	}
+
+	// Unmarshal the dormant rover
+	var claimed Rover
+	err := json.Unmarshal(obj.Data, &claimed)
+	if err != nil {
+		return "", err
+	}
+
+	// Bring it back to life under the same owner
+	claimed.Owner = r.Owner
+	claimed.Pos = r.Pos
+	claimed.AddLogEntryf("claimed by rover %s", r.Name)
+	r.AddLogEntryf("claimed dormant rover %s", claimed.Name)
+
+	w.Atlas.SetObject(r.Pos, Object{Type: roveapi.Object_ObjectUnknown})
+	w.Rovers[claimed.Name] = &claimed
+
+	return claimed.Name, nil
+}
+
 // RoverToggle will toggle the sail position
 func (w *World) RoverToggle(rover string) (roveapi.SailPosition, error) {
 	w.worldMutex.Lock()
@@ -432,7 +729,7 @@ func (w *World) RoverToggle(rover string) (roveapi.SailPosition, error) {
 
 	r, ok := w.Rovers[rover]
 	if !ok {
-		return roveapi.SailPosition_UnknownSailPosition, fmt.Errorf("no rover matching id")
+		return roveapi.SailPosition_UnknownSailPosition, ErrRoverNotFound
 	}
 
 	// Swap the sail position
@@ -449,63 +746,427 @@ func (w *World) RoverToggle(rover string) (roveapi.SailPosition, error) {
 	return r.SailPosition, nil
 }
 
-// RoverUpgrade will try to upgrade the rover
-func (w *World) RoverUpgrade(rover string, upgrade roveapi.RoverUpgrade) (int, error) {
+// RoverInstall will install an inventory component into its equipment slot
+// The component is chosen by type if given, otherwise by inventory index
+func (w *World) RoverInstall(rover string, object roveapi.Object, index int) (roveapi.ComponentSlot, error) {
 	w.worldMutex.Lock()
 	defer w.worldMutex.Unlock()
 
 	r, ok := w.Rovers[rover]
 	if !ok {
-		return 0, fmt.Errorf("no rover matching id")
+		return roveapi.ComponentSlot_ComponentSlotUnknown, ErrRoverNotFound
+	}
+
+	// Find the inventory stack to install from
+	i := -1
+	if object != roveapi.Object_ObjectUnknown {
+		i = r.Inventory.Find(object)
+	} else if index >= 0 && index < len(r.Inventory) {
+		i = index
+	}
+	if i < 0 {
+		r.AddLogEntryf("tried to install component but had no matching object")
+		return roveapi.ComponentSlot_ComponentSlotUnknown, nil
+	}
+
+	t := r.Inventory[i].Type
+	component, ok := FindComponent(t)
+	if !ok {
+		r.AddLogEntryf("tried to install %s but it is not a component", t)
+		return roveapi.ComponentSlot_ComponentSlotUnknown, nil
+	}
+
+	// Only one component per slot
+	if installed, ok := r.Loadout[component.Slot]; ok {
+		r.AddLogEntryf("tried to install %s but %s slot already held %s", t, component.Slot, installed.Type)
+		return roveapi.ComponentSlot_ComponentSlotUnknown, nil
+	}
+
+	// Ensure the rover has energy
+	if r.Charge < w.Rules.CommandCharge {
+		r.AddLogEntryf("tried to install %s but had no charge", t)
+		return roveapi.ComponentSlot_ComponentSlotUnknown, nil
 	}
+	r.Charge -= w.Rules.CommandCharge
 
-	cost := upgradeCost
-	num := 0
-	for i := range r.Inventory {
-		if r.Inventory[i].Type == roveapi.Object_RoverParts {
-			num++
+	// Move the component from the inventory into the slot
+	o, _ := r.Inventory.Take(i)
+	if r.Loadout == nil {
+		r.Loadout = make(map[roveapi.ComponentSlot]Object)
+	}
+	r.Loadout[component.Slot] = o
+	r.UpdateSpecifications(w.Rules)
+
+	r.AddLogEntryf("installed %s into %s slot", t, component.Slot)
+	return component.Slot, nil
+}
+
+// RoverUninstall will uninstall the component in an equipment slot into the inventory
+func (w *World) RoverUninstall(rover string, slot roveapi.ComponentSlot) (roveapi.Object, error) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return roveapi.Object_ObjectUnknown, ErrRoverNotFound
+	}
+
+	o, ok := r.Loadout[slot]
+	if !ok {
+		r.AddLogEntryf("tried to uninstall %s slot but it was empty", slot)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	// Make sure the inventory can hold the component without the capacity it may provide
+	component, _ := FindComponent(o.Type)
+	if r.Inventory.Weight()+o.Weight() > r.Capacity-component.Capacity {
+		r.AddLogEntryf("tried to uninstall %s but inventory could not hold it", o.Type)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	// Ensure the rover has energy
+	if r.Charge < w.Rules.CommandCharge {
+		r.AddLogEntryf("tried to uninstall %s but had no charge", o.Type)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+	r.Charge -= w.Rules.CommandCharge
+
+	// Move the component from the slot into the inventory
+	delete(r.Loadout, slot)
+	r.Inventory.Add(o, 1)
+	r.UpdateSpecifications(w.Rules)
+
+	r.AddLogEntryf("uninstalled %s from %s slot", o.Type, slot)
+	return o.Type, nil
+}
+
+// RoverCraft will start crafting a recipe, consuming the ingredients
+func (w *World) RoverCraft(rover string, name string) (roveapi.Object, error) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return roveapi.Object_ObjectUnknown, ErrRoverNotFound
+	}
+
+	recipe, ok := w.Recipes.Find(name)
+	if !ok {
+		return roveapi.Object_ObjectUnknown, fmt.Errorf("unknown recipe: %s", name)
+	}
+
+	// Only one thing can be crafted at a time
+	if len(r.Crafting) > 0 {
+		r.AddLogEntryf("tried to craft %s but was already crafting %s", recipe.Name, r.Crafting)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	if !r.Inventory.Contains(recipe.Ingredients) {
+		r.AddLogEntryf("tried to craft %s but lacked ingredients", recipe.Name)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	// Ensure the rover has energy
+	if r.Charge < w.Rules.CommandCharge {
+		r.AddLogEntryf("tried to craft %s but had no charge", recipe.Name)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+	r.Charge -= w.Rules.CommandCharge
+
+	// Use up the ingredients and start the craft
+	for t, n := range recipe.Ingredients {
+		r.Inventory.Remove(t, n)
+	}
+	r.Crafting = recipe.Name
+	r.CraftTicks = recipe.Ticks
+	r.AddLogEntryf("started crafting %s", recipe.Name)
+
+	return recipe.Product, nil
+}
+
+// progressCraft will progress any crafting on a rover, producing the object once complete
+func (w *World) progressCraft(rover string) error {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return ErrRoverNotFound
+	}
+
+	if len(r.Crafting) == 0 {
+		return nil
+	}
+
+	recipe, ok := w.Recipes.Find(r.Crafting)
+	if !ok {
+		return fmt.Errorf("rover crafting unknown recipe: %s", r.Crafting)
+	}
+
+	// Count down until the final tick of the craft
+	if r.CraftTicks > 1 {
+		r.CraftTicks--
+		return nil
+	}
+
+	// Hold on to the product until there's space for it, only logging the first time
+	product := Object{Type: recipe.Product}
+	if r.Inventory.Weight()+product.Weight() > r.Capacity {
+		if r.CraftTicks == 1 {
+			r.AddLogEntryf("finished crafting %s but inventory was full", recipe.Name)
 		}
+		r.CraftTicks = 0
+		return nil
+	}
+
+	r.Inventory.Add(product, 1)
+	r.Crafting = ""
+	r.CraftTicks = 0
+	r.AddLogEntryf("crafted %s", product.Type)
+
+	return nil
+}
+
+// RoverBuild will build a structure on the current or an adjacent tile
+func (w *World) RoverBuild(rover string, structure roveapi.Object, bearing roveapi.Bearing, message []byte) error {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return ErrRoverNotFound
 	}
 
-	if num < cost {
-		r.AddLogEntryf("tried to upgrade but lacked rover parts")
-		return 0, nil
+	blueprint, ok := FindBlueprint(structure)
+	if !ok {
+		return fmt.Errorf("unknown structure: %s", structure)
 	}
 
-	// Apply the upgrade
-	var ret int
-	switch upgrade {
-	case roveapi.RoverUpgrade_Capacity:
-		r.Capacity++
-		ret = r.Capacity
-	case roveapi.RoverUpgrade_Range:
-		r.Range++
-		ret = r.Range
-	case roveapi.RoverUpgrade_MaximumCharge:
-		r.MaximumCharge++
-		ret = r.MaximumCharge
-	case roveapi.RoverUpgrade_MaximumIntegrity:
-		r.MaximumIntegrity++
-		ret = r.MaximumIntegrity
-	default:
-		return 0, fmt.Errorf("unknown upgrade: %s", upgrade)
+	// Structures need an empty tile
+	pos := r.Pos.Added(maths.BearingToVector(bearing))
+	_, obj := w.Atlas.QueryPosition(pos)
+	if obj.Type != roveapi.Object_ObjectUnknown {
+		r.AddLogEntryf("tried to build %s but %+v was occupied", structure, pos)
+		return nil
 	}
 
-	// Remove the cost in rover parts
-	var n []Object
-	for _, o := range r.Inventory {
-		if o.Type == roveapi.Object_RoverParts && cost > 0 {
-			cost--
-		} else {
-			n = append(n, o)
+	// And blocking structures can't be built on top of a rover
+	built := Object{Type: structure}
+	if built.IsBlocking() && w.roverAt(pos) {
+		r.AddLogEntryf("tried to build %s but a rover was at %+v", structure, pos)
+		return nil
+	}
+
+	if !r.Inventory.Contains(blueprint.Ingredients) {
+		r.AddLogEntryf("tried to build %s but lacked materials", structure)
+		return nil
+	}
+
+	// Ensure the rover has energy
+	if r.Charge < w.Rules.CommandCharge {
+		r.AddLogEntryf("tried to build %s but had no charge", structure)
+		return nil
+	}
+	r.Charge -= w.Rules.CommandCharge
+
+	if err := built.SetStructure(Structure{Owner: r.Owner, Message: message}); err != nil {
+		return err
+	}
+
+	// Use up the materials and place the structure
+	for t, n := range blueprint.Ingredients {
+		r.Inventory.Remove(t, n)
+	}
+	w.Atlas.SetObject(pos, built)
+	w.Structures = append(w.Structures, pos)
+
+	r.AddLogEntryf("built %s at %+v", structure, pos)
+	return nil
+}
+
+// RoverDeposit will deposit an inventory object into a cache on the current or an adjacent tile
+// The object is chosen by type if given, otherwise by inventory index
+func (w *World) RoverDeposit(rover string, object roveapi.Object, index int, bearing roveapi.Bearing) (roveapi.Object, error) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return roveapi.Object_ObjectUnknown, ErrRoverNotFound
+	}
+
+	pos := r.Pos.Added(maths.BearingToVector(bearing))
+	_, cache := w.Atlas.QueryPosition(pos)
+	if cache.Type != roveapi.Object_Cache {
+		r.AddLogEntryf("tried to deposit object but found no cache at %+v", pos)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	// Find the inventory stack to deposit from
+	i := -1
+	if object != roveapi.Object_ObjectUnknown {
+		i = r.Inventory.Find(object)
+	} else if index >= 0 && index < len(r.Inventory) {
+		i = index
+	}
+	if i < 0 {
+		r.AddLogEntryf("tried to deposit object but had no matching object")
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	s, err := cache.Structure()
+	if err != nil {
+		return roveapi.Object_ObjectUnknown, err
+	}
+
+	if s.Inventory.Weight()+r.Inventory[i].Weight() > w.Rules.CacheCapacity {
+		r.AddLogEntryf("tried to deposit %s but the cache was full", r.Inventory[i].Type)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	// Move the object into the cache
+	o, _ := r.Inventory.Take(i)
+	s.Inventory.Add(o, 1)
+	if err := cache.SetStructure(s); err != nil {
+		return roveapi.Object_ObjectUnknown, err
+	}
+	w.Atlas.SetObject(pos, cache)
+
+	r.AddLogEntryf("deposited %s into cache", o.Type)
+	return o.Type, nil
+}
+
+// RoverWithdraw will withdraw an object from a cache on the current or an adjacent tile
+// The first object in the cache is taken if no type is given
+func (w *World) RoverWithdraw(rover string, object roveapi.Object, bearing roveapi.Bearing) (roveapi.Object, error) {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	r, ok := w.Rovers[rover]
+	if !ok {
+		return roveapi.Object_ObjectUnknown, ErrRoverNotFound
+	}
+
+	pos := r.Pos.Added(maths.BearingToVector(bearing))
+	_, cache := w.Atlas.QueryPosition(pos)
+	if cache.Type != roveapi.Object_Cache {
+		r.AddLogEntryf("tried to withdraw object but found no cache at %+v", pos)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	s, err := cache.Structure()
+	if err != nil {
+		return roveapi.Object_ObjectUnknown, err
+	}
+
+	// Find the cache stack to withdraw from
+	i := 0
+	if object != roveapi.Object_ObjectUnknown {
+		i = s.Inventory.Find(object)
+	}
+	if i < 0 || i >= len(s.Inventory) {
+		r.AddLogEntryf("tried to withdraw object but the cache held no matching object")
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	if r.Inventory.Weight()+s.Inventory[i].Weight() > r.Capacity {
+		r.AddLogEntryf("tried to withdraw %s but inventory was full", s.Inventory[i].Type)
+		return roveapi.Object_ObjectUnknown, nil
+	}
+
+	// Move the object out of the cache
+	o, _ := s.Inventory.Take(i)
+	r.Inventory.Add(o, 1)
+	if err := cache.SetStructure(s); err != nil {
+		return roveapi.Object_ObjectUnknown, err
+	}
+	w.Atlas.SetObject(pos, cache)
+
+	r.AddLogEntryf("withdrew %s from cache", o.Type)
+	return o.Type, nil
+}
+
+// tickStructures will run the effects of all structures for this tick
+func (w *World) tickStructures() error {
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	var remaining []maths.Vector
+	heard := make(map[string][]HeardBeacon)
+	for _, pos := range w.Structures {
+		_, obj := w.Atlas.QueryPosition(pos)
+		if !obj.IsStructure() {
+			// The structure is gone, stop tracking it
+			continue
+		}
+		remaining = append(remaining, pos)
+
+		s, err := obj.Structure()
+		if err != nil {
+			return err
+		}
+
+		switch obj.Type {
+		case roveapi.Object_Beacon:
+			// Broadcast the message to all rovers in range, logged when they come into range or it changes
+			beacon := HeardBeacon{Pos: pos, Message: string(s.Message)}
+			for n, r := range w.Rovers {
+				if pos.Distance(r.Pos) < float64(w.Rules.BeaconRange) {
+					if !r.hasHeard(beacon) {
+						r.AddLogEntryf("recieved %s from beacon at %+v", beacon.Message, pos)
+					}
+					heard[n] = append(heard[n], beacon)
+				}
+			}
+
+		case roveapi.Object_SolarStation:
+			// Recharge all adjacent rovers overnight, logged once a night
+			if w.Daytime() {
+				break
+			}
+			day := w.CurrentTicks/w.TicksPerDay + 1
+			for _, r := range w.Rovers {
+				dist := r.Pos.Added(pos.Negated()).Abs()
+				if dist.X <= 1 && dist.Y <= 1 && r.Charge < r.MaximumCharge {
+					r.Charge = maths.Min(r.Charge+w.Rules.RechargeRate, r.MaximumCharge)
+					if r.RechargedDay != day {
+						r.AddLogEntryf("recharging from solar station, charge now %d", r.Charge)
+						r.RechargedDay = day
+					}
+				}
+			}
 		}
 	}
-	// Assign back the inventory
-	r.Inventory = n
+	w.Structures = remaining
+
+	for n, r := range w.Rovers {
+		r.Heard = heard[n]
+	}
+
+	return nil
+}
 
-	r.AddLogEntryf("upgraded %s to %d", upgrade, ret)
+// roverAt checks if any rover is at a position
+func (w *World) roverAt(pos maths.Vector) bool {
+	for _, r := range w.Rovers {
+		if r.Pos == pos {
+			return true
+		}
+	}
+	return false
+}
 
-	return ret, nil
+// validateMessage checks a message is valid to broadcast
+func (w *World) validateMessage(message []byte) error {
+	if len(message) > w.Rules.BroadcastLength {
+		return fmt.Errorf("too many characters in message (limit %d): %d", w.Rules.BroadcastLength, len(message))
+	}
+	for _, b := range message {
+		if b < 37 || b > 126 {
+			return fmt.Errorf("invalid message character: %c", b)
+		}
+	}
+	return nil
 }
 
 // RoverTurn will turn the rover
@@ -515,7 +1176,7 @@ func (w *World) RoverTurn(rover string, bearing roveapi.Bearing) (roveapi.Bearin
 
 	r, ok := w.Rovers[rover]
 	if !ok {
-		return roveapi.Bearing_BearingUnknown, fmt.Errorf("no rover matching id")
+		return roveapi.Bearing_BearingUnknown, ErrRoverNotFound
 	}
 
 	// Set the new bearing
@@ -533,7 +1194,7 @@ func (w *World) RoverRepair(rover string) (int, error) {
 
 	r, ok := w.Rovers[rover]
 	if !ok {
-		return 0, fmt.Errorf("no rover matching id")
+		return 0, ErrRoverNotFound
 	}
 
 	// Can't repair past max
@@ -541,19 +1202,10 @@ func (w *World) RoverRepair(rover string) (int, error) {
 		return r.Integrity, nil
 	}
 
-	// Find rover parts in inventory
-	for i, o := range r.Inventory {
-		if o.Type == roveapi.Object_RoverParts {
-
-			// Copy-erase from slice
-			r.Inventory[i] = r.Inventory[len(r.Inventory)-1]
-			r.Inventory = r.Inventory[:len(r.Inventory)-1]
-
-			// Repair
-			r.Integrity = r.Integrity + 1
-			r.AddLogEntryf("repaired self to %d", r.Integrity)
-			break
-		}
+	// Use up rover parts from the inventory to repair
+	if r.Inventory.Remove(roveapi.Object_RoverParts, 1) > 0 {
+		r.Integrity = maths.Min(r.Integrity+w.Rules.RepairIntegrity, r.MaximumIntegrity)
+		r.AddLogEntryf("repaired self to %d", r.Integrity)
 	}
 
 	return r.Integrity, nil
@@ -566,7 +1218,7 @@ func (w *World) RadarFromRover(rover string) (radar []roveapi.Tile, objs []rovea
 
 	r, ok := w.Rovers[rover]
 	if !ok {
-		err = fmt.Errorf("no rover matching id")
+		err = ErrRoverNotFound
 		return
 	}
 
@@ -617,6 +1269,244 @@ func (w *World) RadarFromRover(rover string) (radar []roveapi.Tile, objs []rovea
 	return radar, objs, nil
 }
 
+// AtlasArea returns the tiles and objects around a position, in the same layout as the radar
+// Live rovers are included in the objects
+func (w *World) AtlasArea(centre maths.Vector, rng int) (tiles []roveapi.Tile, objs []roveapi.Object, err error) {
+	if rng < 0 || rng > maxAtlasRange {
+		return nil, nil, fmt.Errorf("%w: atlas range must be between 0 and %d: %d", ErrInvalidArgument, maxAtlasRange, rng)
+	}
+
+	w.worldMutex.RLock()
+	defer w.worldMutex.RUnlock()
+
+	span := (rng * 2) + 1
+	min := maths.Vector{X: centre.X - rng, Y: centre.Y - rng}
+	tiles = make([]roveapi.Tile, span*span)
+	objs = make([]roveapi.Object, span*span)
+	for j := 0; j < span; j++ {
+		for i := 0; i < span; i++ {
+			tile, obj := w.Atlas.QueryPosition(maths.Vector{X: min.X + i, Y: min.Y + j})
+			tiles[i+j*span] = tile
+			objs[i+j*span] = obj.Type
+		}
+	}
+
+	for _, r := range w.Rovers {
+		relative := r.Pos.Added(min.Negated())
+		if relative.X >= 0 && relative.X < span && relative.Y >= 0 && relative.Y < span {
+			objs[relative.X+relative.Y*span] = roveapi.Object_RoverLive
+		}
+	}
+
+	return tiles, objs, nil
+}
+
+// SpectatorView is a downsampled view of the generated world
+type SpectatorView struct {
+	// Tick is the tick the view was taken at
+	Tick int
+
+	// Size is the number of samples along each side of the view
+	Size int
+
+	// Tiles and Objects are Size squared samples in the same layout as the radar
+	Tiles   []roveapi.Tile
+	Objects []roveapi.Object
+
+	// Rovers are all the rovers within the view
+	Rovers []RoverPosition
+}
+
+// RoverPosition is where a rover is and who owns it
+type RoverPosition struct {
+	Name  string
+	Owner string
+	Pos   maths.Vector
+}
+
+// SpectateArea returns a view of the area around a position, sampling the centre tile of each scale sized square
+// Only what's already generated is shown, so spectators can't grow the world
+func (w *World) SpectateArea(centre maths.Vector, rng int, scale int) (SpectatorView, error) {
+	if rng < 0 {
+		return SpectatorView{}, fmt.Errorf("%w: spectate range can't be negative: %d", ErrInvalidArgument, rng)
+	} else if scale < 1 {
+		return SpectatorView{}, fmt.Errorf("%w: spectate scale must be at least 1: %d", ErrInvalidArgument, scale)
+	}
+
+	span := (rng * 2) + 1
+	size := (span + scale - 1) / scale
+	if size > maxSpectateSize {
+		return SpectatorView{}, fmt.Errorf("%w: spectate range %d needs a scale of at least %d", ErrInvalidArgument, rng, (span+maxSpectateSize-1)/maxSpectateSize)
+	}
+
+	w.worldMutex.RLock()
+	defer w.worldMutex.RUnlock()
+
+	view := SpectatorView{
+		Tick:    w.CurrentTicks,
+		Size:    size,
+		Tiles:   make([]roveapi.Tile, size*size),
+		Objects: make([]roveapi.Object, size*size),
+	}
+
+	min := maths.Vector{X: centre.X - rng, Y: centre.Y - rng}
+	for j := 0; j < size; j++ {
+		for i := 0; i < size; i++ {
+			sample := maths.Vector{
+				X: min.X + maths.Min(i*scale+scale/2, span-1),
+				Y: min.Y + maths.Min(j*scale+scale/2, span-1),
+			}
+			if tile, obj, ok := w.Atlas.QueryGenerated(sample); ok {
+				view.Tiles[i+j*size] = tile
+				view.Objects[i+j*size] = obj.Type
+			}
+		}
+	}
+
+	for _, r := range w.Rovers {
+		relative := r.Pos.Added(min.Negated())
+		if relative.X >= 0 && relative.X < span && relative.Y >= 0 && relative.Y < span {
+			view.Rovers = append(view.Rovers, RoverPosition{Name: r.Name, Owner: r.Owner, Pos: r.Pos})
+		}
+	}
+	sort.Slice(view.Rovers, func(i, j int) bool {
+		return view.Rovers[i].Name < view.Rovers[j].Name
+	})
+
+	return view, nil
+}
+
+// Snapshot returns the whole world encoded as JSON, in the same form as the save file
+func (w *World) Snapshot() ([]byte, error) {
+	w.cmdMutex.RLock()
+	defer w.cmdMutex.RUnlock()
+	w.worldMutex.RLock()
+	defer w.worldMutex.RUnlock()
+
+	return json.Marshal(w)
+}
+
+// Restore replaces the whole world with a snapshot
+// The snapshot is fully decoded before anything is replaced, so a bad snapshot leaves the world untouched
+func (w *World) Restore(snapshot []byte) error {
+	// The chunk size is replaced by the one in the snapshot
+	fresh := NewWorld(32)
+	if err := json.Unmarshal(snapshot, fresh); err != nil {
+		return fmt.Errorf("%w: failed to decode world snapshot: %s", ErrInvalidArgument, err)
+	}
+
+	w.cmdMutex.Lock()
+	defer w.cmdMutex.Unlock()
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	w.TicksPerDay = fresh.TicksPerDay
+	w.Rules = fresh.Rules
+	w.Recipes = fresh.Recipes
+	w.CurrentTicks = fresh.CurrentTicks
+	w.Rovers = fresh.Rovers
+	w.Atlas = fresh.Atlas
+	w.Wind = fresh.Wind
+	w.CommandQueue = fresh.CommandQueue
+	w.Structures = fresh.Structures
+	w.FleetCap = fresh.FleetCap
+	w.Accountant = fresh.Accountant
+	w.Teams = fresh.Teams
+	w.Explored = fresh.Explored
+
+	// The fresh atlas needs the world's logger
+	w.SetLogger(w.log)
+	return nil
+}
+
+// UnmarshalJSON loads a world, migrating it from any older version
+func (w *World) UnmarshalJSON(b []byte) error {
+	// Worlds saved before versioning have no version at all
+	type world World
+	w.Version = 0
+
+	// Rules added since the world was saved keep their standard values
+	w.Rules = DefaultRules()
+	w.Recipes = nil
+	if err := json.Unmarshal(b, (*world)(w)); err != nil {
+		return err
+	}
+
+	// Recipes are decoded fresh, as decoding over the standard ones would merge their ingredients
+	if w.Recipes == nil {
+		w.Recipes = DefaultRecipes()
+	}
+
+	// Bad rules would only fail later, in the middle of a tick
+	if err := w.Rules.Validate(); err != nil {
+		return fmt.Errorf("world has invalid rules: %w", err)
+	} else if err := w.Recipes.Validate(); err != nil {
+		return fmt.Errorf("world has invalid recipes: %w", err)
+	}
+	w.migrate()
+	return nil
+}
+
+// SetRules replaces the rules, deriving the specifications of every rover again from the new base values
+func (w *World) SetRules(rules Rules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	w.Rules = rules
+	for _, r := range w.Rovers {
+		r.UpdateSpecifications(rules)
+	}
+	return nil
+}
+
+// SetRecipes replaces the recipes, cancelling any crafting of recipes that no longer exist
+func (w *World) SetRecipes(recipes Recipes) error {
+	if err := recipes.Validate(); err != nil {
+		return err
+	}
+
+	w.worldMutex.Lock()
+	defer w.worldMutex.Unlock()
+
+	w.Recipes = recipes
+	for _, r := range w.Rovers {
+		if _, ok := recipes.Find(r.Crafting); len(r.Crafting) > 0 && !ok {
+			r.AddLogEntryf("stopped crafting %s, the recipe no longer exists", r.Crafting)
+			r.Crafting = ""
+			r.CraftTicks = 0
+		}
+	}
+	return nil
+}
+
+// migrate brings a world loaded from an older version up to date
+func (w *World) migrate() {
+	if w.Version < 1 {
+		for _, r := range w.Rovers {
+			r.keepLegacyUpgrades(w.Rules)
+		}
+	}
+
+	// Commands that no longer exist would block their queues forever
+	for rover, cmds := range w.CommandQueue {
+		kept := make(CommandStream, 0, len(cmds))
+		for _, c := range cmds {
+			if _, ok := roveapi.CommandType_name[int32(c.Command)]; ok {
+				kept = append(kept, c)
+			} else {
+				w.log.Warn("Dropping unknown queued command", "rover", rover, "command", c.Command)
+			}
+		}
+		w.CommandQueue[rover] = kept
+	}
+
+	w.Version = worldVersion
+}
+
 // RoverCommands returns current commands for the given rover
 func (w *World) RoverCommands(rover string) (queued CommandStream) {
 	if c, ok := w.CommandQueue[rover]; ok {
@@ -629,34 +1519,10 @@ func (w *World) RoverCommands(rover string) (queued CommandStream) {
 func (w *World) Enqueue(rover string, commands ...*roveapi.Command) error {
 
 	// First validate the commands
-	for _, c := range commands {
-		switch c.Command {
-		case roveapi.CommandType_broadcast:
-			if len(c.GetData()) > 3 {
-				return fmt.Errorf("too many characters in message (limit 3): %d", len(c.GetData()))
-			}
-			for _, b := range c.GetData() {
-				if b < 37 || b > 126 {
-					return fmt.Errorf("invalid message character: %c", b)
-				}
-			}
-		case roveapi.CommandType_turn:
-			if c.GetBearing() == roveapi.Bearing_BearingUnknown {
-				return fmt.Errorf("turn command given unknown bearing")
-			}
-		case roveapi.CommandType_upgrade:
-			if c.GetUpgrade() == roveapi.RoverUpgrade_RoverUpgradeUnknown {
-				return fmt.Errorf("upgrade command given unknown upgrade")
-			}
-		case roveapi.CommandType_wait:
-		case roveapi.CommandType_toggle:
-		case roveapi.CommandType_stash:
-		case roveapi.CommandType_repair:
-		case roveapi.CommandType_salvage:
-		case roveapi.CommandType_transfer:
-			// Nothing to verify
-		default:
-			return fmt.Errorf("unknown command: %s", c.Command)
+	for i, c := range commands {
+		if err := w.validateCommand(c); err != nil {
+			err.Index = i
+			return err
 		}
 	}
 
@@ -669,21 +1535,100 @@ func (w *World) Enqueue(rover string, commands ...*roveapi.Command) error {
 	return nil
 }
 
+// validateCommand checks all the fields needed by a command are valid
+func (w *World) validateCommand(c *roveapi.Command) *CommandError {
+	switch c.Command {
+	case roveapi.CommandType_broadcast:
+		if err := w.validateMessage(c.GetData()); err != nil {
+			return invalidCommand("data", "%s", err)
+		}
+	case roveapi.CommandType_turn:
+		if c.GetBearing() == roveapi.Bearing_BearingUnknown {
+			return invalidCommand("bearing", "turn command given unknown bearing")
+		}
+	case roveapi.CommandType_install:
+		if _, ok := roveapi.Object_name[int32(c.GetObject())]; !ok {
+			return invalidCommand("object", "install command given unknown object: %d", c.GetObject())
+		}
+		if c.GetIndex() < 0 {
+			return invalidCommand("index", "install command given negative index: %d", c.GetIndex())
+		}
+	case roveapi.CommandType_uninstall:
+		if _, ok := roveapi.ComponentSlot_name[int32(c.GetSlot())]; !ok || c.GetSlot() == roveapi.ComponentSlot_ComponentSlotUnknown {
+			return invalidCommand("slot", "uninstall command given unknown slot: %d", c.GetSlot())
+		}
+	case roveapi.CommandType_drop:
+		if _, ok := roveapi.Object_name[int32(c.GetObject())]; !ok {
+			return invalidCommand("object", "drop command given unknown object: %d", c.GetObject())
+		}
+		if c.GetIndex() < 0 {
+			return invalidCommand("index", "drop command given negative index: %d", c.GetIndex())
+		}
+		if _, ok := roveapi.Bearing_name[int32(c.GetBearing())]; !ok {
+			return invalidCommand("bearing", "drop command given unknown bearing: %d", c.GetBearing())
+		}
+	case roveapi.CommandType_craft:
+		if _, ok := w.Recipes.Find(c.GetRecipe()); !ok {
+			return invalidCommand("recipe", "craft command given unknown recipe: %s", c.GetRecipe())
+		}
+	case roveapi.CommandType_build:
+		if _, ok := FindBlueprint(c.GetObject()); !ok {
+			return invalidCommand("object", "build command given unknown structure: %s", c.GetObject())
+		}
+		if c.GetObject() == roveapi.Object_Beacon {
+			if len(c.GetData()) == 0 {
+				return invalidCommand("data", "build command for beacon given no message")
+			} else if err := w.validateMessage(c.GetData()); err != nil {
+				return invalidCommand("data", "%s", err)
+			}
+		}
+		if _, ok := roveapi.Bearing_name[int32(c.GetBearing())]; !ok {
+			return invalidCommand("bearing", "build command given unknown bearing: %d", c.GetBearing())
+		}
+	case roveapi.CommandType_deposit, roveapi.CommandType_withdraw:
+		if _, ok := roveapi.Object_name[int32(c.GetObject())]; !ok {
+			return invalidCommand("object", "%s command given unknown object: %d", c.Command, c.GetObject())
+		}
+		if c.GetIndex() < 0 {
+			return invalidCommand("index", "%s command given negative index: %d", c.Command, c.GetIndex())
+		}
+		if _, ok := roveapi.Bearing_name[int32(c.GetBearing())]; !ok {
+			return invalidCommand("bearing", "%s command given unknown bearing: %d", c.Command, c.GetBearing())
+		}
+	case roveapi.CommandType_wait:
+	case roveapi.CommandType_toggle:
+	case roveapi.CommandType_stash:
+	case roveapi.CommandType_repair:
+	case roveapi.CommandType_salvage:
+	case roveapi.CommandType_transfer:
+	case roveapi.CommandType_claim:
+		// Nothing to verify
+	default:
+		return invalidCommand("command", "unknown command: %s", c.Command)
+	}
+	return nil
+}
+
 // Tick will execute any commands in the current command queue and tick the world
 func (w *World) Tick() {
 	w.cmdMutex.Lock()
 	defer w.cmdMutex.Unlock()
 
+	start := time.Now()
+
 	// Iterate through all the current commands
 	for rover, cmds := range w.CommandQueue {
 		if len(cmds) != 0 {
 
 			// Execute the command
-			if done, err := w.ExecuteCommand(cmds[0], rover); err != nil {
-				log.Println(err)
+			done, err := w.ExecuteCommand(cmds[0], rover)
+			if err != nil {
+				// Drop the command, otherwise it blocks the queue failing every tick
+				w.log.Error("Failed to execute command", "rover", rover, "command", cmds[0].Command, "error", err)
 				// TODO: Report this error somehow
+			}
 
-			} else if done {
+			if done || err != nil {
 				// Extract the first command in the queue
 				// Only if the command queue still has entries (the command may have modified this queue)
 				if _, ok := w.CommandQueue[rover]; ok {
@@ -697,6 +1642,14 @@ func (w *World) Tick() {
 		}
 	}
 
+	// Progress any crafting
+	for n := range w.Rovers {
+		if err := w.progressCraft(n); err != nil {
+			w.log.Error("Failed to progress crafting", "rover", n, "error", err)
+			// TODO: Report this error somehow
+		}
+	}
+
 	// Move all the rovers based on current wind and sails
 	for n, r := range w.Rovers {
 		// Skip if we're not catching the wind
@@ -714,32 +1667,14 @@ func (w *World) Tick() {
 			diff = 8 - diff
 		}
 
-		// Calculate the travel "ticks"
-		var ticksToMove int
-		switch diff {
-		case 0:
-			// Going with the wind, travel at base speed of once every 4 ticks
-			ticksToMove = ticksPerNormalMove
-		case 1:
-			// At a slight angle, we can go a little faster
-			ticksToMove = ticksPerNormalMove / 2
-		case 2:
-			// Perpendicular to wind, max speed
-			ticksToMove = 1
-		case 3:
-			// Heading at 45 degrees into the wind, back to min speed
-			ticksToMove = ticksPerNormalMove
-		case 4:
-			// Heading durectly into the wind, no movement at all
-		default:
-			log.Fatalf("bearing difference of %d should be impossible", diff)
-		}
+		// Look up the travel "ticks" for this angle to the wind
+		ticksToMove := w.Rules.SailTicks[diff]
 
 		// If we've incremented over the current move ticks on the rover, we can try and make the move
 		if ticksToMove != 0 && r.MoveTicks >= ticksToMove {
 			_, err := w.TryMoveRover(n, r.Bearing)
 			if err != nil {
-				log.Println(err)
+				w.log.Error("Failed to sail rover", "rover", n, "error", err)
 				// TODO: Report this error somehow
 			}
 
@@ -748,25 +1683,51 @@ func (w *World) Tick() {
 		}
 	}
 
+	// Run all the structures
+	if err := w.tickStructures(); err != nil {
+		w.log.Error("Failed to run structures", "error", err)
+		// TODO: Report this error somehow
+	}
+
+	// Record everything the rovers can now see
+	w.explore()
+
 	// Check all rover integrities
 	for _, r := range w.Rovers {
 		if r.Integrity <= 0 {
-			// The rover has died destroy it
+			// The rover has died destroy it, which clears its owner
+			owner := r.Owner
+			w.log.Info("Rover destroyed", "rover", r.Name, "account", owner)
 			err := w.DestroyRover(r.Name)
 			if err != nil {
-				log.Println(err)
+				w.log.Error("Failed to destroy rover", "rover", r.Name, "error", err)
 				// TODO: Report this error somehow
 			}
 
-			// Spawn a new one for this account
-			_, err = w.SpawnRover(r.Owner)
+			// Spawn a new one if that was the last of the account's fleet
+			if len(owner) == 0 {
+				continue
+			}
+			_, err = w.EnsureFleet(owner)
 			if err != nil {
-				log.Println(err)
+				w.log.Error("Failed to respawn rover", "account", owner, "error", err)
 				// TODO: Report this error somehow
 			}
 		}
 	}
 
+	// Limit the number of logs
+	for _, r := range w.Rovers {
+		if w.log.Enabled(logging.LevelDebug) {
+			for _, e := range r.Logs {
+				if !e.Time.Before(start) {
+					w.log.Debug("Rover log entry", "rover", r.Name, "text", e.Text)
+				}
+			}
+		}
+		r.TrimLogs(w.Rules.MaxLogEntries)
+	}
+
 	// Increment the current tick count
 	w.CurrentTicks++
 
@@ -778,7 +1739,7 @@ func (w *World) Tick() {
 
 // ExecuteCommand will execute a single command
 func (w *World) ExecuteCommand(c *roveapi.Command, rover string) (done bool, err error) {
-	log.Printf("Executing command: %+v for %s\n", c.Command, rover)
+	w.log.Debug("Executing command", "rover", rover, "command", c.Command)
 
 	switch c.Command {
 	case roveapi.CommandType_toggle:
@@ -795,8 +1756,22 @@ func (w *World) ExecuteCommand(c *roveapi.Command, rover string) (done bool, err
 		_, err = w.RoverSalvage(rover)
 	case roveapi.CommandType_transfer:
 		_, err = w.RoverTransfer(rover)
-	case roveapi.CommandType_upgrade:
-		_, err = w.RoverUpgrade(rover, c.GetUpgrade())
+	case roveapi.CommandType_claim:
+		_, err = w.RoverClaim(rover)
+	case roveapi.CommandType_install:
+		_, err = w.RoverInstall(rover, c.GetObject(), int(c.GetIndex()))
+	case roveapi.CommandType_uninstall:
+		_, err = w.RoverUninstall(rover, c.GetSlot())
+	case roveapi.CommandType_drop:
+		_, err = w.RoverDrop(rover, c.GetObject(), int(c.GetIndex()), c.GetBearing())
+	case roveapi.CommandType_craft:
+		_, err = w.RoverCraft(rover, c.GetRecipe())
+	case roveapi.CommandType_build:
+		err = w.RoverBuild(rover, c.GetObject(), c.GetBearing(), c.GetData())
+	case roveapi.CommandType_deposit:
+		_, err = w.RoverDeposit(rover, c.GetObject(), int(c.GetIndex()), c.GetBearing())
+	case roveapi.CommandType_withdraw:
+		_, err = w.RoverWithdraw(rover, c.GetObject(), c.GetBearing())
 	case roveapi.CommandType_wait:
 		// Nothing to do
 	default:
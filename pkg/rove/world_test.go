@@ -1,8 +1,13 @@
 package rove
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sync"
 	"testing"
 
+	"github.com/mdiluz/rove/pkg/logging"
 	"github.com/mdiluz/rove/pkg/maths"
 	"github.com/mdiluz/rove/proto/roveapi"
 	"github.com/stretchr/testify/assert"
@@ -60,6 +65,50 @@ func TestWorld_DestroyRover(t *testing.T) {
 	}
 }
 
+func TestWorld_DestroyRoverOnCache(t *testing.T) {
+	world := NewWorld(4)
+	a, err := world.SpawnRover("")
+	assert.NoError(t, err)
+	pos := world.Rovers[a].Pos
+
+	// Place a stocked cache under the rover, with free space to the north
+	cache := Object{Type: roveapi.Object_Cache}
+	s := Structure{}
+	s.Inventory.Add(Object{Type: roveapi.Object_RoverParts}, 3)
+	assert.NoError(t, cache.SetStructure(s))
+	world.Atlas.SetObject(pos, cache)
+	north := pos.Added(maths.Vector{Y: 1})
+	world.Atlas.SetObject(north, Object{Type: roveapi.Object_ObjectUnknown})
+
+	assert.NoError(t, world.DestroyRover(a))
+
+	// The cache and its items survive
+	_, obj := world.Atlas.QueryPosition(pos)
+	assert.Equal(t, roveapi.Object_Cache, obj.Type)
+	s, err = obj.Structure()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, s.Inventory.Count(roveapi.Object_RoverParts))
+
+	// And the dormant rover is left next to it
+	_, obj = world.Atlas.QueryPosition(north)
+	assert.Equal(t, roveapi.Object_RoverDormant, obj.Type)
+	var dormant Rover
+	assert.NoError(t, json.Unmarshal(obj.Data, &dormant))
+	assert.Equal(t, north, dormant.Pos)
+
+	// With nowhere free the rover isn't destroyed
+	b, err := world.SpawnRover("owner")
+	assert.NoError(t, err)
+	world.Rovers[b].Pos = pos
+	for bearing := roveapi.Bearing_North; bearing <= roveapi.Bearing_NorthWest; bearing++ {
+		world.Atlas.SetObject(pos.Added(maths.BearingToVector(bearing)), Object{Type: roveapi.Object_RockSmall})
+	}
+	assert.True(t, errors.Is(world.DestroyRover(b), ErrNoSpace))
+	assert.Equal(t, "owner", world.Rovers[b].Owner)
+	_, obj = world.Atlas.QueryPosition(pos)
+	assert.Equal(t, roveapi.Object_Cache, obj.Type)
+}
+
 func TestWorld_GetSetMovePosition(t *testing.T) {
 	world := NewWorld(4)
 	a, err := world.SpawnRover("")
@@ -131,7 +180,7 @@ func TestWorld_RadarFromRover(t *testing.T) {
 
 func TestWorld_RoverDamage(t *testing.T) {
 	world := NewWorld(2)
-	acc, err := world.Accountant.RegisterAccount("tmp")
+	acc, _, err := world.Accountant.RegisterAccount("tmp")
 	assert.NoError(t, err)
 	a, err := world.SpawnRover(acc.Name)
 	assert.NoError(t, err)
@@ -177,6 +226,67 @@ func TestWorld_RoverDamage(t *testing.T) {
 	assert.Equal(t, roveapi.Object_RoverDormant, obj.Type)
 }
 
+func TestWorld_RespawnOnDeath(t *testing.T) {
+	world := NewWorld(4)
+	a, err := world.SpawnRover("owner")
+	assert.NoError(t, err)
+
+	// The last rover of a fleet is replaced for its owner
+	world.Rovers[a].Integrity = 0
+	world.Tick()
+	fleet := world.Fleet("owner")
+	assert.Len(t, fleet, 1)
+	assert.NotEqual(t, a, fleet[0])
+	for _, r := range world.Rovers {
+		assert.NotEmpty(t, r.Owner)
+	}
+
+	// But losing one of several isn't
+	b, err := world.SpawnRover("owner")
+	assert.NoError(t, err)
+	world.Rovers[b].Integrity = 0
+	world.Tick()
+	assert.Equal(t, fleet, world.Fleet("owner"))
+	assert.Len(t, world.Rovers, 1)
+}
+
+func TestWorld_EnsureFleet(t *testing.T) {
+	world := NewWorld(4)
+
+	// Concurrent callers only spawn one rover between them
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := world.EnsureFleet("owner")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, world.Fleet("owner"), 1)
+
+	// A fleet with rovers is left alone
+	name, err := world.EnsureFleet("owner")
+	assert.NoError(t, err)
+	assert.Empty(t, name)
+	assert.Len(t, world.Fleet("owner"), 1)
+}
+
+func TestWorld_SpawnRoverFleetCap(t *testing.T) {
+	world := NewWorld(4)
+	world.FleetCap = 2
+
+	_, err := world.SpawnRover("owner")
+	assert.NoError(t, err)
+	_, err = world.SpawnRover("owner")
+	assert.NoError(t, err)
+
+	_, err = world.SpawnRover("owner")
+	assert.True(t, errors.Is(err, ErrFleetFull))
+	assert.Len(t, world.Fleet("owner"), 2)
+}
+
 func TestWorld_Daytime(t *testing.T) {
 	world := NewWorld(1)
 
@@ -310,14 +420,14 @@ func TestWorld_Sailing(t *testing.T) {
 	assert.Equal(t, maths.Vector{Y: 0}, info.Pos)
 
 	// Loop a few more times
-	for i := 0; i < ticksPerNormalMove-2; i++ {
+	for i := 0; i < world.Rules.SailTicks[0]-2; i++ {
 		world.Tick()
 		info, err := world.GetRover(name)
 		assert.NoError(t, err)
 		assert.Equal(t, maths.Vector{Y: 0}, info.Pos)
 	}
 
-	// Now check we've moved (after the TicksPerNormalMove number of ticks)
+	// Now check we've moved (after the normal move number of ticks)
 	world.Tick()
 	info, err = world.GetRover(name)
 	assert.NoError(t, err)
@@ -332,7 +442,7 @@ func TestWorld_Sailing(t *testing.T) {
 	assert.Equal(t, roveapi.Bearing_South, b)
 
 	// Tick a bunch, we should never move
-	for i := 0; i < ticksPerNormalMove*2; i++ {
+	for i := 0; i < world.Rules.SailTicks[0]*2; i++ {
 		world.Tick()
 		info, err := world.GetRover(name)
 		assert.NoError(t, err)
@@ -357,3 +467,149 @@ func TestWorld_Sailing(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, maths.Vector{X: 1, Y: 2}, info.Pos)
 }
+
+func TestWorld_AtlasArea(t *testing.T) {
+	world := NewWorld(4)
+	a, err := world.SpawnRover("")
+	assert.NoError(t, err)
+	assert.NoError(t, world.WarpRover(a, maths.Vector{X: 1, Y: 1}))
+
+	tiles, objs, err := world.AtlasArea(maths.Vector{X: 0, Y: 0}, 2)
+	assert.NoError(t, err)
+	assert.Len(t, tiles, 25)
+	assert.Len(t, objs, 25)
+	assert.Equal(t, roveapi.Object_RoverLive, objs[3+3*5])
+
+	_, _, err = world.AtlasArea(maths.Vector{}, -1)
+	assert.Error(t, err)
+}
+
+func TestWorld_SnapshotRestore(t *testing.T) {
+	world := NewWorld(4)
+	a, err := world.SpawnRover("")
+	assert.NoError(t, err)
+	world.CurrentTicks = 10
+	snapshot, err := world.Snapshot()
+	assert.NoError(t, err)
+
+	other := NewWorld(8)
+	b, err := other.SpawnRover("")
+	assert.NoError(t, err)
+	assert.Error(t, other.Restore([]byte("not a world")))
+	_, err = other.GetRover(b)
+	assert.NoError(t, err, "A bad snapshot should leave the world alone")
+
+	assert.NoError(t, other.Restore(snapshot))
+	assert.Equal(t, 10, other.CurrentTicks)
+	_, err = other.GetRover(a)
+	assert.NoError(t, err)
+	_, err = other.GetRover(b)
+	assert.Error(t, err)
+}
+
+func TestWorld_LoadLegacy(t *testing.T) {
+	world := NewWorld(4)
+	name, err := world.SpawnRover("")
+	assert.NoError(t, err)
+
+	// Older versions upgraded specifications directly and could queue the removed upgrade command
+	r := world.Rovers[name]
+	r.Range += 2
+	r.MaximumCharge++
+	r.Charge = r.MaximumCharge
+	world.CommandQueue[name] = CommandStream{{Command: roveapi.CommandType(9)}, {Command: roveapi.CommandType_wait}}
+	snapshot, err := world.Snapshot()
+	assert.NoError(t, err)
+
+	var raw map[string]interface{}
+	assert.NoError(t, json.Unmarshal(snapshot, &raw))
+	delete(raw, "Version")
+	snapshot, err = json.Marshal(raw)
+	assert.NoError(t, err)
+
+	loaded := NewWorld(4)
+	assert.NoError(t, json.Unmarshal(snapshot, loaded))
+	assert.Equal(t, worldVersion, loaded.Version)
+	assert.Equal(t, CommandStream{{Command: roveapi.CommandType_wait}}, loaded.CommandQueue[name])
+
+	// The upgrades survive specifications being derived again
+	r = loaded.Rovers[name]
+	assert.Equal(t, RoverUpgrades{Range: 2, MaximumCharge: 1}, r.Upgrades)
+	assert.Equal(t, loaded.Rules.BaseMaximumCharge+1, r.Charge)
+	r.Inventory.Add(Object{Type: roveapi.Object_AntennaBooster}, 1)
+	_, err = loaded.RoverInstall(name, roveapi.Object_AntennaBooster, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, loaded.Rules.BaseRange+2+5, r.Range)
+	assert.Equal(t, loaded.Rules.BaseMaximumCharge+1, r.MaximumCharge)
+
+	// Current worlds are left alone
+	snapshot, err = loaded.Snapshot()
+	assert.NoError(t, err)
+	again := NewWorld(4)
+	assert.NoError(t, json.Unmarshal(snapshot, again))
+	assert.Equal(t, r.Upgrades, again.Rovers[name].Upgrades)
+}
+
+func TestWorld_TickDropsFailedCommands(t *testing.T) {
+	world := NewWorld(4)
+	name, err := world.SpawnRover("")
+	assert.NoError(t, err)
+
+	// A command that fails is dropped rather than blocking the queue
+	world.CommandQueue[name] = CommandStream{{Command: roveapi.CommandType(9)}, {Command: roveapi.CommandType_wait}}
+	world.Tick()
+	assert.Equal(t, CommandStream{{Command: roveapi.CommandType_wait}}, world.RoverCommands(name))
+}
+
+func TestWorld_Logging(t *testing.T) {
+	world := NewWorld(4)
+	name, err := world.SpawnRover("")
+	assert.NoError(t, err)
+	assert.NoError(t, world.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_broadcast, Data: []byte("HI")}))
+
+	// Commands and rover log entries are only logged at debug level
+	var b bytes.Buffer
+	world.SetLogger(logging.New(&b, logging.Config{Level: logging.LevelInfo}).Named("world"))
+	world.Tick()
+	assert.Empty(t, b.String())
+
+	assert.NoError(t, world.Enqueue(name, &roveapi.Command{Command: roveapi.CommandType_broadcast, Data: []byte("HI")}))
+	world.SetLogger(logging.New(&b, logging.Config{Level: logging.LevelDebug}).Named("world"))
+	world.Tick()
+	assert.Contains(t, b.String(), "world: Executing command rover="+name+" command=broadcast")
+	assert.Contains(t, b.String(), "world: Rover log entry rover="+name)
+}
+
+func TestWorld_SpectateArea(t *testing.T) {
+	world := NewWorld(4)
+	name, err := world.SpawnRover("owner")
+	assert.NoError(t, err)
+	rover, err := world.GetRover(name)
+	assert.NoError(t, err)
+
+	_, err = world.SpectateArea(maths.Vector{}, -1, 1)
+	assert.Error(t, err)
+	_, err = world.SpectateArea(maths.Vector{}, 1, 0)
+	assert.Error(t, err)
+	_, err = world.SpectateArea(maths.Vector{}, 1000, 1)
+	assert.Error(t, err)
+
+	// Around the rover everything has been generated
+	view, err := world.SpectateArea(rover.Pos, 2, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, view.Size)
+	assert.Len(t, view.Tiles, 25)
+	assert.NotEqual(t, roveapi.Tile_TileUnknown, view.Tiles[2+2*5])
+	assert.Equal(t, []RoverPosition{{Name: name, Owner: "owner", Pos: rover.Pos}}, view.Rovers)
+
+	// Far away nothing is generated, and looking doesn't generate it
+	chunks := world.Atlas.Stats().Chunks
+	view, err = world.SpectateArea(maths.Vector{X: 1000, Y: 1000}, 10, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, view.Size)
+	for _, tile := range view.Tiles {
+		assert.Equal(t, roveapi.Tile_TileUnknown, tile)
+	}
+	assert.Empty(t, view.Rovers)
+	assert.Equal(t, chunks, world.Atlas.Stats().Chunks)
+}
@@ -0,0 +1,2326 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.6.1
+// source: roveadmin/roveadmin.proto
+
+// Rove Admin
+//
+// RoveAdmin is the operator interface to a Rove server, used to inspect and fix
+// up the world
+
+package roveadmin
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	roveapi "github.com/mdiluz/rove/proto/roveapi"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// ListAccountsRequest is an empty placeholder
+type ListAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{0}
+}
+
+// AccountInfo describes a registered account
+type AccountInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The team the account is in, if any
+	Team string `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	// The live rovers owned by the account
+	Rovers []string `protobuf:"bytes,3,rep,name=rovers,proto3" json:"rovers,omitempty"`
+	// The time the account was last active in RFC3339 form, if known
+	Active string `protobuf:"bytes,4,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *AccountInfo) Reset() {
+	*x = AccountInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInfo) ProtoMessage() {}
+
+func (x *AccountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
+func (*AccountInfo) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetRovers() []string {
+	if x != nil {
+		return x.Rovers
+	}
+	return nil
+}
+
+func (x *AccountInfo) GetActive() string {
+	if x != nil {
+		return x.Active
+	}
+	return ""
+}
+
+// ListAccountsResponse lists all accounts
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All accounts sorted by name
+	Accounts []*AccountInfo `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*AccountInfo {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// ListRoversRequest filters the rovers to list
+type ListRoversRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list rovers owned by this account, if set
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *ListRoversRequest) Reset() {
+	*x = ListRoversRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoversRequest) ProtoMessage() {}
+
+func (x *ListRoversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoversRequest.ProtoReflect.Descriptor instead.
+func (*ListRoversRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRoversRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// RoverInfo describes a live rover
+type RoverInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the rover
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The account that owns the rover, if any
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Position of the rover in world coordinates
+	Position *roveapi.Vector `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	// The current and maximum health of the rover
+	Integrity        int32 `protobuf:"varint,4,opt,name=integrity,proto3" json:"integrity,omitempty"`
+	MaximumIntegrity int32 `protobuf:"varint,5,opt,name=maximumIntegrity,proto3" json:"maximumIntegrity,omitempty"`
+	// The current and maximum energy of the rover
+	Charge        int32 `protobuf:"varint,6,opt,name=charge,proto3" json:"charge,omitempty"`
+	MaximumCharge int32 `protobuf:"varint,7,opt,name=maximumCharge,proto3" json:"maximumCharge,omitempty"`
+}
+
+func (x *RoverInfo) Reset() {
+	*x = RoverInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoverInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoverInfo) ProtoMessage() {}
+
+func (x *RoverInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoverInfo.ProtoReflect.Descriptor instead.
+func (*RoverInfo) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoverInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoverInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *RoverInfo) GetPosition() *roveapi.Vector {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *RoverInfo) GetIntegrity() int32 {
+	if x != nil {
+		return x.Integrity
+	}
+	return 0
+}
+
+func (x *RoverInfo) GetMaximumIntegrity() int32 {
+	if x != nil {
+		return x.MaximumIntegrity
+	}
+	return 0
+}
+
+func (x *RoverInfo) GetCharge() int32 {
+	if x != nil {
+		return x.Charge
+	}
+	return 0
+}
+
+func (x *RoverInfo) GetMaximumCharge() int32 {
+	if x != nil {
+		return x.MaximumCharge
+	}
+	return 0
+}
+
+// ListRoversResponse lists rovers
+type ListRoversResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rovers sorted by name
+	Rovers []*RoverInfo `protobuf:"bytes,1,rep,name=rovers,proto3" json:"rovers,omitempty"`
+}
+
+func (x *ListRoversResponse) Reset() {
+	*x = ListRoversResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRoversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoversResponse) ProtoMessage() {}
+
+func (x *ListRoversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoversResponse.ProtoReflect.Descriptor instead.
+func (*ListRoversResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRoversResponse) GetRovers() []*RoverInfo {
+	if x != nil {
+		return x.Rovers
+	}
+	return nil
+}
+
+// WarpRoverRequest describes where to warp a rover
+type WarpRoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rover to warp
+	Rover string `protobuf:"bytes,1,opt,name=rover,proto3" json:"rover,omitempty"`
+	// The position to warp to
+	Position *roveapi.Vector `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *WarpRoverRequest) Reset() {
+	*x = WarpRoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarpRoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarpRoverRequest) ProtoMessage() {}
+
+func (x *WarpRoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarpRoverRequest.ProtoReflect.Descriptor instead.
+func (*WarpRoverRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WarpRoverRequest) GetRover() string {
+	if x != nil {
+		return x.Rover
+	}
+	return ""
+}
+
+func (x *WarpRoverRequest) GetPosition() *roveapi.Vector {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+// WarpRoverResponse is an empty placeholder
+type WarpRoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WarpRoverResponse) Reset() {
+	*x = WarpRoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarpRoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarpRoverResponse) ProtoMessage() {}
+
+func (x *WarpRoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarpRoverResponse.ProtoReflect.Descriptor instead.
+func (*WarpRoverResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{7}
+}
+
+// SetRoverStatsRequest describes the stats to set, unset stats are left alone
+type SetRoverStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rover to change
+	Rover string `protobuf:"bytes,1,opt,name=rover,proto3" json:"rover,omitempty"`
+	// The new integrity
+	Integrity *wrappers.Int32Value `protobuf:"bytes,2,opt,name=integrity,proto3" json:"integrity,omitempty"`
+	// The new charge
+	Charge *wrappers.Int32Value `protobuf:"bytes,3,opt,name=charge,proto3" json:"charge,omitempty"`
+}
+
+func (x *SetRoverStatsRequest) Reset() {
+	*x = SetRoverStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRoverStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoverStatsRequest) ProtoMessage() {}
+
+func (x *SetRoverStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoverStatsRequest.ProtoReflect.Descriptor instead.
+func (*SetRoverStatsRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetRoverStatsRequest) GetRover() string {
+	if x != nil {
+		return x.Rover
+	}
+	return ""
+}
+
+func (x *SetRoverStatsRequest) GetIntegrity() *wrappers.Int32Value {
+	if x != nil {
+		return x.Integrity
+	}
+	return nil
+}
+
+func (x *SetRoverStatsRequest) GetCharge() *wrappers.Int32Value {
+	if x != nil {
+		return x.Charge
+	}
+	return nil
+}
+
+// SetRoverStatsResponse describes the rover after the change
+type SetRoverStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rover *RoverInfo `protobuf:"bytes,1,opt,name=rover,proto3" json:"rover,omitempty"`
+}
+
+func (x *SetRoverStatsResponse) Reset() {
+	*x = SetRoverStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRoverStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoverStatsResponse) ProtoMessage() {}
+
+func (x *SetRoverStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoverStatsResponse.ProtoReflect.Descriptor instead.
+func (*SetRoverStatsResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetRoverStatsResponse) GetRover() *RoverInfo {
+	if x != nil {
+		return x.Rover
+	}
+	return nil
+}
+
+// ForceTickRequest describes how many ticks to run
+type ForceTickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of ticks to run, defaults to 1
+	Ticks int32 `protobuf:"varint,1,opt,name=ticks,proto3" json:"ticks,omitempty"`
+}
+
+func (x *ForceTickRequest) Reset() {
+	*x = ForceTickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceTickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceTickRequest) ProtoMessage() {}
+
+func (x *ForceTickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceTickRequest.ProtoReflect.Descriptor instead.
+func (*ForceTickRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ForceTickRequest) GetTicks() int32 {
+	if x != nil {
+		return x.Ticks
+	}
+	return 0
+}
+
+// ForceTickResponse is the response to forcing ticks
+type ForceTickResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current tick of the server after the forced ticks
+	CurrentTick int32 `protobuf:"varint,1,opt,name=currentTick,proto3" json:"currentTick,omitempty"`
+}
+
+func (x *ForceTickResponse) Reset() {
+	*x = ForceTickResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceTickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceTickResponse) ProtoMessage() {}
+
+func (x *ForceTickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceTickResponse.ProtoReflect.Descriptor instead.
+func (*ForceTickResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ForceTickResponse) GetCurrentTick() int32 {
+	if x != nil {
+		return x.CurrentTick
+	}
+	return 0
+}
+
+// SetWindRequest describes the new wind
+type SetWindRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new wind direction
+	Wind roveapi.Bearing `protobuf:"varint,1,opt,name=wind,proto3,enum=roveapi.Bearing" json:"wind,omitempty"`
+}
+
+func (x *SetWindRequest) Reset() {
+	*x = SetWindRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWindRequest) ProtoMessage() {}
+
+func (x *SetWindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWindRequest.ProtoReflect.Descriptor instead.
+func (*SetWindRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetWindRequest) GetWind() roveapi.Bearing {
+	if x != nil {
+		return x.Wind
+	}
+	return roveapi.Bearing_BearingUnknown
+}
+
+// SetWindResponse is an empty placeholder
+type SetWindResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetWindResponse) Reset() {
+	*x = SetWindResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWindResponse) ProtoMessage() {}
+
+func (x *SetWindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWindResponse.ProtoReflect.Descriptor instead.
+func (*SetWindResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{13}
+}
+
+// KillRoverRequest describes the rover to kill
+type KillRoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rover to kill
+	Rover string `protobuf:"bytes,1,opt,name=rover,proto3" json:"rover,omitempty"`
+}
+
+func (x *KillRoverRequest) Reset() {
+	*x = KillRoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillRoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillRoverRequest) ProtoMessage() {}
+
+func (x *KillRoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillRoverRequest.ProtoReflect.Descriptor instead.
+func (*KillRoverRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KillRoverRequest) GetRover() string {
+	if x != nil {
+		return x.Rover
+	}
+	return ""
+}
+
+// KillRoverResponse is the response to killing a rover
+type KillRoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The newly spawned rover for the owner, if the rover was the last of their fleet
+	Respawned string `protobuf:"bytes,1,opt,name=respawned,proto3" json:"respawned,omitempty"`
+}
+
+func (x *KillRoverResponse) Reset() {
+	*x = KillRoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillRoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillRoverResponse) ProtoMessage() {}
+
+func (x *KillRoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillRoverResponse.ProtoReflect.Descriptor instead.
+func (*KillRoverResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KillRoverResponse) GetRespawned() string {
+	if x != nil {
+		return x.Respawned
+	}
+	return ""
+}
+
+// SaveNowRequest is an empty placeholder
+type SaveNowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SaveNowRequest) Reset() {
+	*x = SaveNowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveNowRequest) ProtoMessage() {}
+
+func (x *SaveNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveNowRequest.ProtoReflect.Descriptor instead.
+func (*SaveNowRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{16}
+}
+
+// SaveNowResponse is an empty placeholder
+type SaveNowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SaveNowResponse) Reset() {
+	*x = SaveNowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveNowResponse) ProtoMessage() {}
+
+func (x *SaveNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveNowResponse.ProtoReflect.Descriptor instead.
+func (*SaveNowResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{17}
+}
+
+// AtlasRequest describes the area to query
+type AtlasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The centre of the area
+	Centre *roveapi.Vector `protobuf:"bytes,1,opt,name=centre,proto3" json:"centre,omitempty"`
+	// The distance from the centre to each edge of the area
+	Range int32 `protobuf:"varint,2,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *AtlasRequest) Reset() {
+	*x = AtlasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AtlasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtlasRequest) ProtoMessage() {}
+
+func (x *AtlasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtlasRequest.ProtoReflect.Descriptor instead.
+func (*AtlasRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AtlasRequest) GetCentre() *roveapi.Vector {
+	if x != nil {
+		return x.Centre
+	}
+	return nil
+}
+
+func (x *AtlasRequest) GetRange() int32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+// AtlasResponse describes an area of the world
+type AtlasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The range of the area
+	Range int32 `protobuf:"varint,1,opt,name=range,proto3" json:"range,omitempty"`
+	// A 1D array representing range*2 + 1 squared set of tiles, origin bottom
+	// left and in row->column order, the same as the radar
+	Tiles []roveapi.Tile `protobuf:"varint,2,rep,packed,name=tiles,proto3,enum=roveapi.Tile" json:"tiles,omitempty"`
+	// A similar array to the tile array, but containing objects, including live
+	// rovers
+	Objects []roveapi.Object `protobuf:"varint,3,rep,packed,name=objects,proto3,enum=roveapi.Object" json:"objects,omitempty"`
+}
+
+func (x *AtlasResponse) Reset() {
+	*x = AtlasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AtlasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtlasResponse) ProtoMessage() {}
+
+func (x *AtlasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtlasResponse.ProtoReflect.Descriptor instead.
+func (*AtlasResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AtlasResponse) GetRange() int32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *AtlasResponse) GetTiles() []roveapi.Tile {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *AtlasResponse) GetObjects() []roveapi.Object {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+// ExportWorldRequest is an empty placeholder
+type ExportWorldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportWorldRequest) Reset() {
+	*x = ExportWorldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorldRequest) ProtoMessage() {}
+
+func (x *ExportWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorldRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorldRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{20}
+}
+
+// ExportWorldResponse contains a world snapshot
+type ExportWorldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The whole world encoded as JSON, in the same form as the save file
+	Snapshot []byte `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *ExportWorldResponse) Reset() {
+	*x = ExportWorldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorldResponse) ProtoMessage() {}
+
+func (x *ExportWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorldResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorldResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportWorldResponse) GetSnapshot() []byte {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// ImportWorldRequest contains the world snapshot to import
+type ImportWorldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The whole world encoded as JSON, in the same form as the save file
+	Snapshot []byte `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *ImportWorldRequest) Reset() {
+	*x = ImportWorldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportWorldRequest) ProtoMessage() {}
+
+func (x *ImportWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportWorldRequest.ProtoReflect.Descriptor instead.
+func (*ImportWorldRequest) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportWorldRequest) GetSnapshot() []byte {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// ImportWorldResponse is an empty placeholder
+type ImportWorldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ImportWorldResponse) Reset() {
+	*x = ImportWorldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveadmin_roveadmin_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportWorldResponse) ProtoMessage() {}
+
+func (x *ImportWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveadmin_roveadmin_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportWorldResponse.ProtoReflect.Descriptor instead.
+func (*ImportWorldResponse) Descriptor() ([]byte, []int) {
+	return file_roveadmin_roveadmin_proto_rawDescGZIP(), []int{23}
+}
+
+var File_roveadmin_roveadmin_proto protoreflect.FileDescriptor
+
+var file_roveadmin_roveadmin_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x6f, 0x76, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x15, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x4a, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x76, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x6f, 0x76, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x10, 0x57, 0x61, 0x72, 0x70, 0x52,
+	0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x13,
+	0x0a, 0x11, 0x57, 0x61, 0x72, 0x70, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x33, 0x0a,
+	0x06, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x28, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x63, 0x6b,
+	0x73, 0x22, 0x35, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x36, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x57,
+	0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x77, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x64,
+	0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x10, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x31, 0x0a,
+	0x11, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x64,
+	0x22, 0x10, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x0c, 0x41, 0x74, 0x6c, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x22, 0x75, 0x0a, 0x0d, 0x41, 0x74, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x31, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x22, 0x30, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc5, 0x06,
+	0x0a, 0x09, 0x52, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x51, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x72, 0x6f,
+	0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x6f,
+	0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x72,
+	0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x57,
+	0x61, 0x72, 0x70, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x76, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64,
+	0x12, 0x19, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x6f,
+	0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x4b, 0x69, 0x6c,
+	0x6c, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x53, 0x61, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x12, 0x19,
+	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4e,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x6f, 0x76, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x05, 0x41, 0x74, 0x6c, 0x61, 0x73,
+	0x12, 0x17, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x74, 0x6c,
+	0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x6f, 0x76, 0x65,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x74, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x64, 0x69, 0x6c, 0x75, 0x7a, 0x2f, 0x72, 0x6f, 0x76, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_roveadmin_roveadmin_proto_rawDescOnce sync.Once
+	file_roveadmin_roveadmin_proto_rawDescData = file_roveadmin_roveadmin_proto_rawDesc
+)
+
+func file_roveadmin_roveadmin_proto_rawDescGZIP() []byte {
+	file_roveadmin_roveadmin_proto_rawDescOnce.Do(func() {
+		file_roveadmin_roveadmin_proto_rawDescData = protoimpl.X.CompressGZIP(file_roveadmin_roveadmin_proto_rawDescData)
+	})
+	return file_roveadmin_roveadmin_proto_rawDescData
+}
+
+var file_roveadmin_roveadmin_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_roveadmin_roveadmin_proto_goTypes = []interface{}{
+	(*ListAccountsRequest)(nil),   // 0: roveadmin.ListAccountsRequest
+	(*AccountInfo)(nil),           // 1: roveadmin.AccountInfo
+	(*ListAccountsResponse)(nil),  // 2: roveadmin.ListAccountsResponse
+	(*ListRoversRequest)(nil),     // 3: roveadmin.ListRoversRequest
+	(*RoverInfo)(nil),             // 4: roveadmin.RoverInfo
+	(*ListRoversResponse)(nil),    // 5: roveadmin.ListRoversResponse
+	(*WarpRoverRequest)(nil),      // 6: roveadmin.WarpRoverRequest
+	(*WarpRoverResponse)(nil),     // 7: roveadmin.WarpRoverResponse
+	(*SetRoverStatsRequest)(nil),  // 8: roveadmin.SetRoverStatsRequest
+	(*SetRoverStatsResponse)(nil), // 9: roveadmin.SetRoverStatsResponse
+	(*ForceTickRequest)(nil),      // 10: roveadmin.ForceTickRequest
+	(*ForceTickResponse)(nil),     // 11: roveadmin.ForceTickResponse
+	(*SetWindRequest)(nil),        // 12: roveadmin.SetWindRequest
+	(*SetWindResponse)(nil),       // 13: roveadmin.SetWindResponse
+	(*KillRoverRequest)(nil),      // 14: roveadmin.KillRoverRequest
+	(*KillRoverResponse)(nil),     // 15: roveadmin.KillRoverResponse
+	(*SaveNowRequest)(nil),        // 16: roveadmin.SaveNowRequest
+	(*SaveNowResponse)(nil),       // 17: roveadmin.SaveNowResponse
+	(*AtlasRequest)(nil),          // 18: roveadmin.AtlasRequest
+	(*AtlasResponse)(nil),         // 19: roveadmin.AtlasResponse
+	(*ExportWorldRequest)(nil),    // 20: roveadmin.ExportWorldRequest
+	(*ExportWorldResponse)(nil),   // 21: roveadmin.ExportWorldResponse
+	(*ImportWorldRequest)(nil),    // 22: roveadmin.ImportWorldRequest
+	(*ImportWorldResponse)(nil),   // 23: roveadmin.ImportWorldResponse
+	(*roveapi.Vector)(nil),        // 24: roveapi.Vector
+	(*wrappers.Int32Value)(nil),   // 25: google.protobuf.Int32Value
+	(roveapi.Bearing)(0),          // 26: roveapi.Bearing
+	(roveapi.Tile)(0),             // 27: roveapi.Tile
+	(roveapi.Object)(0),           // 28: roveapi.Object
+}
+var file_roveadmin_roveadmin_proto_depIdxs = []int32{
+	1,  // 0: roveadmin.ListAccountsResponse.accounts:type_name -> roveadmin.AccountInfo
+	24, // 1: roveadmin.RoverInfo.position:type_name -> roveapi.Vector
+	4,  // 2: roveadmin.ListRoversResponse.rovers:type_name -> roveadmin.RoverInfo
+	24, // 3: roveadmin.WarpRoverRequest.position:type_name -> roveapi.Vector
+	25, // 4: roveadmin.SetRoverStatsRequest.integrity:type_name -> google.protobuf.Int32Value
+	25, // 5: roveadmin.SetRoverStatsRequest.charge:type_name -> google.protobuf.Int32Value
+	4,  // 6: roveadmin.SetRoverStatsResponse.rover:type_name -> roveadmin.RoverInfo
+	26, // 7: roveadmin.SetWindRequest.wind:type_name -> roveapi.Bearing
+	24, // 8: roveadmin.AtlasRequest.centre:type_name -> roveapi.Vector
+	27, // 9: roveadmin.AtlasResponse.tiles:type_name -> roveapi.Tile
+	28, // 10: roveadmin.AtlasResponse.objects:type_name -> roveapi.Object
+	0,  // 11: roveadmin.RoveAdmin.ListAccounts:input_type -> roveadmin.ListAccountsRequest
+	3,  // 12: roveadmin.RoveAdmin.ListRovers:input_type -> roveadmin.ListRoversRequest
+	6,  // 13: roveadmin.RoveAdmin.WarpRover:input_type -> roveadmin.WarpRoverRequest
+	8,  // 14: roveadmin.RoveAdmin.SetRoverStats:input_type -> roveadmin.SetRoverStatsRequest
+	10, // 15: roveadmin.RoveAdmin.ForceTick:input_type -> roveadmin.ForceTickRequest
+	12, // 16: roveadmin.RoveAdmin.SetWind:input_type -> roveadmin.SetWindRequest
+	14, // 17: roveadmin.RoveAdmin.KillRover:input_type -> roveadmin.KillRoverRequest
+	16, // 18: roveadmin.RoveAdmin.SaveNow:input_type -> roveadmin.SaveNowRequest
+	18, // 19: roveadmin.RoveAdmin.Atlas:input_type -> roveadmin.AtlasRequest
+	20, // 20: roveadmin.RoveAdmin.ExportWorld:input_type -> roveadmin.ExportWorldRequest
+	22, // 21: roveadmin.RoveAdmin.ImportWorld:input_type -> roveadmin.ImportWorldRequest
+	2,  // 22: roveadmin.RoveAdmin.ListAccounts:output_type -> roveadmin.ListAccountsResponse
+	5,  // 23: roveadmin.RoveAdmin.ListRovers:output_type -> roveadmin.ListRoversResponse
+	7,  // 24: roveadmin.RoveAdmin.WarpRover:output_type -> roveadmin.WarpRoverResponse
+	9,  // 25: roveadmin.RoveAdmin.SetRoverStats:output_type -> roveadmin.SetRoverStatsResponse
+	11, // 26: roveadmin.RoveAdmin.ForceTick:output_type -> roveadmin.ForceTickResponse
+	13, // 27: roveadmin.RoveAdmin.SetWind:output_type -> roveadmin.SetWindResponse
+	15, // 28: roveadmin.RoveAdmin.KillRover:output_type -> roveadmin.KillRoverResponse
+	17, // 29: roveadmin.RoveAdmin.SaveNow:output_type -> roveadmin.SaveNowResponse
+	19, // 30: roveadmin.RoveAdmin.Atlas:output_type -> roveadmin.AtlasResponse
+	21, // 31: roveadmin.RoveAdmin.ExportWorld:output_type -> roveadmin.ExportWorldResponse
+	23, // 32: roveadmin.RoveAdmin.ImportWorld:output_type -> roveadmin.ImportWorldResponse
+	22, // [22:33] is the sub-list for method output_type
+	11, // [11:22] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_roveadmin_roveadmin_proto_init() }
+func file_roveadmin_roveadmin_proto_init() {
+	if File_roveadmin_roveadmin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_roveadmin_roveadmin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoversRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoverInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoversResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarpRoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarpRoverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRoverStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRoverStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceTickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceTickResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWindRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWindResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillRoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillRoverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveNowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveNowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AtlasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AtlasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWorldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWorldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportWorldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveadmin_roveadmin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportWorldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_roveadmin_roveadmin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_roveadmin_roveadmin_proto_goTypes,
+		DependencyIndexes: file_roveadmin_roveadmin_proto_depIdxs,
+		MessageInfos:      file_roveadmin_roveadmin_proto_msgTypes,
+	}.Build()
+	File_roveadmin_roveadmin_proto = out.File
+	file_roveadmin_roveadmin_proto_rawDesc = nil
+	file_roveadmin_roveadmin_proto_goTypes = nil
+	file_roveadmin_roveadmin_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RoveAdminClient is the client API for RoveAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RoveAdminClient interface {
+	// List accounts
+	// Lists all registered accounts with their teams and rovers
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// List rovers
+	// Lists all live rovers, optionally only those owned by an account
+	ListRovers(ctx context.Context, in *ListRoversRequest, opts ...grpc.CallOption) (*ListRoversResponse, error)
+	// Warp a rover
+	// Moves a rover directly to a new position, which must not be blocked
+	WarpRover(ctx context.Context, in *WarpRoverRequest, opts ...grpc.CallOption) (*WarpRoverResponse, error)
+	// Set rover stats
+	// Sets the integrity and/or charge of a rover, clamped to its maximums
+	SetRoverStats(ctx context.Context, in *SetRoverStatsRequest, opts ...grpc.CallOption) (*SetRoverStatsResponse, error)
+	// Force a tick
+	// Immediately ticks the world one or more times
+	ForceTick(ctx context.Context, in *ForceTickRequest, opts ...grpc.CallOption) (*ForceTickResponse, error)
+	// Set the wind
+	// Sets the current wind direction
+	SetWind(ctx context.Context, in *SetWindRequest, opts ...grpc.CallOption) (*SetWindResponse, error)
+	// Kill a rover
+	// Destroys a rover, leaving it dormant, and respawns its owner as if it had
+	// died
+	KillRover(ctx context.Context, in *KillRoverRequest, opts ...grpc.CallOption) (*KillRoverResponse, error)
+	// Save now
+	// Saves the world to disk immediately
+	SaveNow(ctx context.Context, in *SaveNowRequest, opts ...grpc.CallOption) (*SaveNowResponse, error)
+	// Atlas
+	// Queries the tiles and objects of any area of the world
+	Atlas(ctx context.Context, in *AtlasRequest, opts ...grpc.CallOption) (*AtlasResponse, error)
+	// Export the world
+	// Returns a snapshot of the whole world
+	ExportWorld(ctx context.Context, in *ExportWorldRequest, opts ...grpc.CallOption) (*ExportWorldResponse, error)
+	// Import the world
+	// Replaces the whole world with a snapshot and saves it
+	ImportWorld(ctx context.Context, in *ImportWorldRequest, opts ...grpc.CallOption) (*ImportWorldResponse, error)
+}
+
+type roveAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoveAdminClient(cc grpc.ClientConnInterface) RoveAdminClient {
+	return &roveAdminClient{cc}
+}
+
+func (c *roveAdminClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) ListRovers(ctx context.Context, in *ListRoversRequest, opts ...grpc.CallOption) (*ListRoversResponse, error) {
+	out := new(ListRoversResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/ListRovers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) WarpRover(ctx context.Context, in *WarpRoverRequest, opts ...grpc.CallOption) (*WarpRoverResponse, error) {
+	out := new(WarpRoverResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/WarpRover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) SetRoverStats(ctx context.Context, in *SetRoverStatsRequest, opts ...grpc.CallOption) (*SetRoverStatsResponse, error) {
+	out := new(SetRoverStatsResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/SetRoverStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) ForceTick(ctx context.Context, in *ForceTickRequest, opts ...grpc.CallOption) (*ForceTickResponse, error) {
+	out := new(ForceTickResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/ForceTick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) SetWind(ctx context.Context, in *SetWindRequest, opts ...grpc.CallOption) (*SetWindResponse, error) {
+	out := new(SetWindResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/SetWind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) KillRover(ctx context.Context, in *KillRoverRequest, opts ...grpc.CallOption) (*KillRoverResponse, error) {
+	out := new(KillRoverResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/KillRover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) SaveNow(ctx context.Context, in *SaveNowRequest, opts ...grpc.CallOption) (*SaveNowResponse, error) {
+	out := new(SaveNowResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/SaveNow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) Atlas(ctx context.Context, in *AtlasRequest, opts ...grpc.CallOption) (*AtlasResponse, error) {
+	out := new(AtlasResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/Atlas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) ExportWorld(ctx context.Context, in *ExportWorldRequest, opts ...grpc.CallOption) (*ExportWorldResponse, error) {
+	out := new(ExportWorldResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/ExportWorld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveAdminClient) ImportWorld(ctx context.Context, in *ImportWorldRequest, opts ...grpc.CallOption) (*ImportWorldResponse, error) {
+	out := new(ImportWorldResponse)
+	err := c.cc.Invoke(ctx, "/roveadmin.RoveAdmin/ImportWorld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoveAdminServer is the server API for RoveAdmin service.
+type RoveAdminServer interface {
+	// List accounts
+	// Lists all registered accounts with their teams and rovers
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// List rovers
+	// Lists all live rovers, optionally only those owned by an account
+	ListRovers(context.Context, *ListRoversRequest) (*ListRoversResponse, error)
+	// Warp a rover
+	// Moves a rover directly to a new position, which must not be blocked
+	WarpRover(context.Context, *WarpRoverRequest) (*WarpRoverResponse, error)
+	// Set rover stats
+	// Sets the integrity and/or charge of a rover, clamped to its maximums
+	SetRoverStats(context.Context, *SetRoverStatsRequest) (*SetRoverStatsResponse, error)
+	// Force a tick
+	// Immediately ticks the world one or more times
+	ForceTick(context.Context, *ForceTickRequest) (*ForceTickResponse, error)
+	// Set the wind
+	// Sets the current wind direction
+	SetWind(context.Context, *SetWindRequest) (*SetWindResponse, error)
+	// Kill a rover
+	// Destroys a rover, leaving it dormant, and respawns its owner as if it had
+	// died
+	KillRover(context.Context, *KillRoverRequest) (*KillRoverResponse, error)
+	// Save now
+	// Saves the world to disk immediately
+	SaveNow(context.Context, *SaveNowRequest) (*SaveNowResponse, error)
+	// Atlas
+	// Queries the tiles and objects of any area of the world
+	Atlas(context.Context, *AtlasRequest) (*AtlasResponse, error)
+	// Export the world
+	// Returns a snapshot of the whole world
+	ExportWorld(context.Context, *ExportWorldRequest) (*ExportWorldResponse, error)
+	// Import the world
+	// Replaces the whole world with a snapshot and saves it
+	ImportWorld(context.Context, *ImportWorldRequest) (*ImportWorldResponse, error)
+}
+
+// UnimplementedRoveAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedRoveAdminServer struct {
+}
+
+func (*UnimplementedRoveAdminServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (*UnimplementedRoveAdminServer) ListRovers(context.Context, *ListRoversRequest) (*ListRoversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRovers not implemented")
+}
+func (*UnimplementedRoveAdminServer) WarpRover(context.Context, *WarpRoverRequest) (*WarpRoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarpRover not implemented")
+}
+func (*UnimplementedRoveAdminServer) SetRoverStats(context.Context, *SetRoverStatsRequest) (*SetRoverStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoverStats not implemented")
+}
+func (*UnimplementedRoveAdminServer) ForceTick(context.Context, *ForceTickRequest) (*ForceTickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTick not implemented")
+}
+func (*UnimplementedRoveAdminServer) SetWind(context.Context, *SetWindRequest) (*SetWindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWind not implemented")
+}
+func (*UnimplementedRoveAdminServer) KillRover(context.Context, *KillRoverRequest) (*KillRoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillRover not implemented")
+}
+func (*UnimplementedRoveAdminServer) SaveNow(context.Context, *SaveNowRequest) (*SaveNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveNow not implemented")
+}
+func (*UnimplementedRoveAdminServer) Atlas(context.Context, *AtlasRequest) (*AtlasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Atlas not implemented")
+}
+func (*UnimplementedRoveAdminServer) ExportWorld(context.Context, *ExportWorldRequest) (*ExportWorldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorld not implemented")
+}
+func (*UnimplementedRoveAdminServer) ImportWorld(context.Context, *ImportWorldRequest) (*ImportWorldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorld not implemented")
+}
+
+func RegisterRoveAdminServer(s *grpc.Server, srv RoveAdminServer) {
+	s.RegisterService(&_RoveAdmin_serviceDesc, srv)
+}
+
+func _RoveAdmin_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_ListRovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).ListRovers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/ListRovers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).ListRovers(ctx, req.(*ListRoversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_WarpRover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpRoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).WarpRover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/WarpRover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).WarpRover(ctx, req.(*WarpRoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_SetRoverStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoverStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).SetRoverStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/SetRoverStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).SetRoverStats(ctx, req.(*SetRoverStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_ForceTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).ForceTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/ForceTick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).ForceTick(ctx, req.(*ForceTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_SetWind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).SetWind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/SetWind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).SetWind(ctx, req.(*SetWindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_KillRover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillRoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).KillRover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/KillRover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).KillRover(ctx, req.(*KillRoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_SaveNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).SaveNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/SaveNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).SaveNow(ctx, req.(*SaveNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_Atlas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AtlasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).Atlas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/Atlas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).Atlas(ctx, req.(*AtlasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_ExportWorld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).ExportWorld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/ExportWorld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).ExportWorld(ctx, req.(*ExportWorldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoveAdmin_ImportWorld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveAdminServer).ImportWorld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveadmin.RoveAdmin/ImportWorld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveAdminServer).ImportWorld(ctx, req.(*ImportWorldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RoveAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "roveadmin.RoveAdmin",
+	HandlerType: (*RoveAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAccounts",
+			Handler:    _RoveAdmin_ListAccounts_Handler,
+		},
+		{
+			MethodName: "ListRovers",
+			Handler:    _RoveAdmin_ListRovers_Handler,
+		},
+		{
+			MethodName: "WarpRover",
+			Handler:    _RoveAdmin_WarpRover_Handler,
+		},
+		{
+			MethodName: "SetRoverStats",
+			Handler:    _RoveAdmin_SetRoverStats_Handler,
+		},
+		{
+			MethodName: "ForceTick",
+			Handler:    _RoveAdmin_ForceTick_Handler,
+		},
+		{
+			MethodName: "SetWind",
+			Handler:    _RoveAdmin_SetWind_Handler,
+		},
+		{
+			MethodName: "KillRover",
+			Handler:    _RoveAdmin_KillRover_Handler,
+		},
+		{
+			MethodName: "SaveNow",
+			Handler:    _RoveAdmin_SaveNow_Handler,
+		},
+		{
+			MethodName: "Atlas",
+			Handler:    _RoveAdmin_Atlas_Handler,
+		},
+		{
+			MethodName: "ExportWorld",
+			Handler:    _RoveAdmin_ExportWorld_Handler,
+		},
+		{
+			MethodName: "ImportWorld",
+			Handler:    _RoveAdmin_ImportWorld_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "roveadmin/roveadmin.proto",
+}
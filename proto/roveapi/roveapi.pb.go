@@ -55,23 +55,48 @@ const (
 	CommandType_salvage CommandType = 7
 	// Transfers remote control into dormant rover
 	CommandType_transfer CommandType = 8
-	// Upgrades a chosen rover specification using 5 rover parts
-	CommandType_upgrade CommandType = 9
+	// Drops an inventory object onto the current or an adjacent tile (requires
+	// object or index)
+	CommandType_drop CommandType = 10
+	// Starts crafting a component from inventory objects (requires recipe)
+	CommandType_craft CommandType = 11
+	// Installs an inventory component into its equipment slot (requires object or
+	// index)
+	CommandType_install CommandType = 12
+	// Uninstalls the component in an equipment slot into the inventory (requires
+	// slot)
+	CommandType_uninstall CommandType = 13
+	// Builds a structure on the current or an adjacent tile (requires object,
+	// beacons also require data)
+	CommandType_build CommandType = 14
+	// Deposits an inventory object into a cache (requires object or index)
+	CommandType_deposit CommandType = 15
+	// Withdraws an object from a cache, optionally of a specific object type
+	CommandType_withdraw CommandType = 16
+	// Claims a dormant rover on the current tile into the account fleet
+	CommandType_claim CommandType = 17
 )
 
 // Enum value maps for CommandType.
 var (
 	CommandType_name = map[int32]string{
-		0: "none",
-		1: "wait",
-		2: "toggle",
-		3: "turn",
-		4: "stash",
-		5: "repair",
-		6: "broadcast",
-		7: "salvage",
-		8: "transfer",
-		9: "upgrade",
+		0:  "none",
+		1:  "wait",
+		2:  "toggle",
+		3:  "turn",
+		4:  "stash",
+		5:  "repair",
+		6:  "broadcast",
+		7:  "salvage",
+		8:  "transfer",
+		10: "drop",
+		11: "craft",
+		12: "install",
+		13: "uninstall",
+		14: "build",
+		15: "deposit",
+		16: "withdraw",
+		17: "claim",
 	}
 	CommandType_value = map[string]int32{
 		"none":      0,
@@ -83,7 +108,14 @@ var (
 		"broadcast": 6,
 		"salvage":   7,
 		"transfer":  8,
-		"upgrade":   9,
+		"drop":      10,
+		"craft":     11,
+		"install":   12,
+		"uninstall": 13,
+		"build":     14,
+		"deposit":   15,
+		"withdraw":  16,
+		"claim":     17,
 	}
 )
 
@@ -183,59 +215,67 @@ func (Bearing) EnumDescriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{1}
 }
 
-// Describes the type of upgrade
-type RoverUpgrade int32
+// ComponentSlot describes an equipment slot on the rover
+type ComponentSlot int32
 
 const (
-	RoverUpgrade_RoverUpgradeUnknown RoverUpgrade = 0
-	RoverUpgrade_Range               RoverUpgrade = 1
-	RoverUpgrade_Capacity            RoverUpgrade = 2
-	RoverUpgrade_MaximumIntegrity    RoverUpgrade = 3
-	RoverUpgrade_MaximumCharge       RoverUpgrade = 4
+	ComponentSlot_ComponentSlotUnknown ComponentSlot = 0
+	// Antenna holds components that extend radar and broadcast range
+	ComponentSlot_Antenna ComponentSlot = 1
+	// Battery holds components that store charge
+	ComponentSlot_Battery ComponentSlot = 2
+	// Hull holds components that protect the rover
+	ComponentSlot_Hull ComponentSlot = 3
+	// Sail holds components mounted on the solar sail
+	ComponentSlot_Sail ComponentSlot = 4
+	// Drill holds components for extracting and carrying material
+	ComponentSlot_Drill ComponentSlot = 5
 )
 
-// Enum value maps for RoverUpgrade.
+// Enum value maps for ComponentSlot.
 var (
-	RoverUpgrade_name = map[int32]string{
-		0: "RoverUpgradeUnknown",
-		1: "Range",
-		2: "Capacity",
-		3: "MaximumIntegrity",
-		4: "MaximumCharge",
-	}
-	RoverUpgrade_value = map[string]int32{
-		"RoverUpgradeUnknown": 0,
-		"Range":               1,
-		"Capacity":            2,
-		"MaximumIntegrity":    3,
-		"MaximumCharge":       4,
+	ComponentSlot_name = map[int32]string{
+		0: "ComponentSlotUnknown",
+		1: "Antenna",
+		2: "Battery",
+		3: "Hull",
+		4: "Sail",
+		5: "Drill",
+	}
+	ComponentSlot_value = map[string]int32{
+		"ComponentSlotUnknown": 0,
+		"Antenna":              1,
+		"Battery":              2,
+		"Hull":                 3,
+		"Sail":                 4,
+		"Drill":                5,
 	}
 )
 
-func (x RoverUpgrade) Enum() *RoverUpgrade {
-	p := new(RoverUpgrade)
+func (x ComponentSlot) Enum() *ComponentSlot {
+	p := new(ComponentSlot)
 	*p = x
 	return p
 }
 
-func (x RoverUpgrade) String() string {
+func (x ComponentSlot) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (RoverUpgrade) Descriptor() protoreflect.EnumDescriptor {
+func (ComponentSlot) Descriptor() protoreflect.EnumDescriptor {
 	return file_roveapi_roveapi_proto_enumTypes[2].Descriptor()
 }
 
-func (RoverUpgrade) Type() protoreflect.EnumType {
+func (ComponentSlot) Type() protoreflect.EnumType {
 	return &file_roveapi_roveapi_proto_enumTypes[2]
 }
 
-func (x RoverUpgrade) Number() protoreflect.EnumNumber {
+func (x ComponentSlot) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use RoverUpgrade.Descriptor instead.
-func (RoverUpgrade) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use ComponentSlot.Descriptor instead.
+func (ComponentSlot) EnumDescriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{2}
 }
 
@@ -256,25 +296,59 @@ const (
 	// RoverParts is one unit of rover parts, used for repairing and fixing the
 	// rover
 	Object_RoverParts Object = 5
+	// SolarPanel is a crafted sail component that adds maximum charge
+	Object_SolarPanel Object = 6
+	// AntennaBooster is a crafted antenna component that adds range
+	Object_AntennaBooster Object = 7
+	// HullPlate is a crafted hull component that adds maximum integrity
+	Object_HullPlate Object = 8
+	// BatteryCell is a crafted battery component that adds maximum charge
+	Object_BatteryCell Object = 9
+	// DrillBit is a crafted drill component that adds inventory capacity
+	Object_DrillBit Object = 10
+	// Beacon is a blocking structure that broadcasts a message to nearby rovers
+	// every tick
+	Object_Beacon Object = 11
+	// SolarStation is a blocking structure that recharges adjacent rovers at
+	// night
+	Object_SolarStation Object = 12
+	// Cache is a structure that stores objects for any rover to take
+	Object_Cache Object = 13
 )
 
 // Enum value maps for Object.
 var (
 	Object_name = map[int32]string{
-		0: "ObjectUnknown",
-		1: "RoverLive",
-		2: "RoverDormant",
-		3: "RockSmall",
-		4: "RockLarge",
-		5: "RoverParts",
+		0:  "ObjectUnknown",
+		1:  "RoverLive",
+		2:  "RoverDormant",
+		3:  "RockSmall",
+		4:  "RockLarge",
+		5:  "RoverParts",
+		6:  "SolarPanel",
+		7:  "AntennaBooster",
+		8:  "HullPlate",
+		9:  "BatteryCell",
+		10: "DrillBit",
+		11: "Beacon",
+		12: "SolarStation",
+		13: "Cache",
 	}
 	Object_value = map[string]int32{
-		"ObjectUnknown": 0,
-		"RoverLive":     1,
-		"RoverDormant":  2,
-		"RockSmall":     3,
-		"RockLarge":     4,
-		"RoverParts":    5,
+		"ObjectUnknown":  0,
+		"RoverLive":      1,
+		"RoverDormant":   2,
+		"RockSmall":      3,
+		"RockLarge":      4,
+		"RoverParts":     5,
+		"SolarPanel":     6,
+		"AntennaBooster": 7,
+		"HullPlate":      8,
+		"BatteryCell":    9,
+		"DrillBit":       10,
+		"Beacon":         11,
+		"SolarStation":   12,
+		"Cache":          13,
 	}
 )
 
@@ -463,11 +537,16 @@ type ServerStatusResponse struct {
 	// Whether the server is ready to accept requests
 	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
 	// The tick rate of the server in minutes (how many minutes per tick)
+	// Only set when the server ticks every whole number of minutes, see schedule
 	TickRate int32 `protobuf:"varint,3,opt,name=tickRate,proto3" json:"tickRate,omitempty"`
 	// The current tick of the server
 	CurrentTick int32 `protobuf:"varint,4,opt,name=currentTick,proto3" json:"currentTick,omitempty"`
-	// The time the next tick will occur
+	// The time the next tick will occur in RFC3339 form, empty if no tick is
+	// scheduled
 	NextTick string `protobuf:"bytes,5,opt,name=next_tick,json=nextTick,proto3" json:"next_tick,omitempty"`
+	// The tick schedule of the server, such as "every 30s", "cron */5 * * * *"
+	// or "manual"
+	Schedule string `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (x *ServerStatusResponse) Reset() {
@@ -537,6 +616,13 @@ func (x *ServerStatusResponse) GetNextTick() string {
 	return ""
 }
 
+func (x *ServerStatusResponse) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
 // RegisterRequest contains data to register an account
 type RegisterRequest struct {
 	state         protoimpl.MessageState
@@ -693,27 +779,16 @@ func (x *RegisterResponse) GetAccount() *Account {
 	return nil
 }
 
-// Command is a single command for a rover
-type Command struct {
+// LoginRequest is an empty placeholder, the account is taken from the
+// request metadata
+type LoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The command type
-	Command CommandType `protobuf:"varint,1,opt,name=command,proto3,enum=roveapi.CommandType" json:"command,omitempty"`
-	// The number of times to repeat the command after the first
-	Repeat int32 `protobuf:"varint,2,opt,name=repeat,proto3" json:"repeat,omitempty"`
-	// broadcast - a simple message, must be composed of up to 3 printable ASCII
-	// glyphs (32-126)
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	// move - the bearing for the rover to turn to
-	Bearing Bearing `protobuf:"varint,4,opt,name=bearing,proto3,enum=roveapi.Bearing" json:"bearing,omitempty"`
-	// upgrade - the upgrade to apply to the rover
-	Upgrade RoverUpgrade `protobuf:"varint,5,opt,name=upgrade,proto3,enum=roveapi.RoverUpgrade" json:"upgrade,omitempty"`
 }
 
-func (x *Command) Reset() {
-	*x = Command{}
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,13 +796,13 @@ func (x *Command) Reset() {
 	}
 }
 
-func (x *Command) String() string {
+func (x *LoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Command) ProtoMessage() {}
+func (*LoginRequest) ProtoMessage() {}
 
-func (x *Command) ProtoReflect() protoreflect.Message {
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -739,60 +814,25 @@ func (x *Command) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Command.ProtoReflect.Descriptor instead.
-func (*Command) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Command) GetCommand() CommandType {
-	if x != nil {
-		return x.Command
-	}
-	return CommandType_none
-}
-
-func (x *Command) GetRepeat() int32 {
-	if x != nil {
-		return x.Repeat
-	}
-	return 0
-}
-
-func (x *Command) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *Command) GetBearing() Bearing {
-	if x != nil {
-		return x.Bearing
-	}
-	return Bearing_BearingUnknown
-}
-
-func (x *Command) GetUpgrade() RoverUpgrade {
-	if x != nil {
-		return x.Upgrade
-	}
-	return RoverUpgrade_RoverUpgradeUnknown
-}
-
-// CommandRequest describes a set of commands to be requested for the rover
-type CommandRequest struct {
+// LoginResponse is the response given to logging in
+type LoginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The account to execute these commands
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The set of desired commands
-	Commands []*Command `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
+	// The session token to send as "rove-token" metadata
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The time the session token expires in RFC3339 form
+	Expires string `protobuf:"bytes,2,opt,name=expires,proto3" json:"expires,omitempty"`
 }
 
-func (x *CommandRequest) Reset() {
-	*x = CommandRequest{}
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,13 +840,13 @@ func (x *CommandRequest) Reset() {
 	}
 }
 
-func (x *CommandRequest) String() string {
+func (x *LoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommandRequest) ProtoMessage() {}
+func (*LoginResponse) ProtoMessage() {}
 
-func (x *CommandRequest) ProtoReflect() protoreflect.Message {
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,34 +858,35 @@ func (x *CommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommandRequest.ProtoReflect.Descriptor instead.
-func (*CommandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CommandRequest) GetAccount() *Account {
+func (x *LoginResponse) GetToken() string {
 	if x != nil {
-		return x.Account
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *CommandRequest) GetCommands() []*Command {
+func (x *LoginResponse) GetExpires() string {
 	if x != nil {
-		return x.Commands
+		return x.Expires
 	}
-	return nil
+	return ""
 }
 
-// CommandResponse is an empty placeholder
-type CommandResponse struct {
+// RotateSecretRequest is an empty placeholder, the account is taken from the
+// request metadata
+type RotateSecretRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CommandResponse) Reset() {
-	*x = CommandResponse{}
+func (x *RotateSecretRequest) Reset() {
+	*x = RotateSecretRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,13 +894,13 @@ func (x *CommandResponse) Reset() {
 	}
 }
 
-func (x *CommandResponse) String() string {
+func (x *RotateSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommandResponse) ProtoMessage() {}
+func (*RotateSecretRequest) ProtoMessage() {}
 
-func (x *CommandResponse) ProtoReflect() protoreflect.Message {
+func (x *RotateSecretRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -871,23 +912,23 @@ func (x *CommandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommandResponse.ProtoReflect.Descriptor instead.
-func (*CommandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateSecretRequest) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{7}
 }
 
-// RadarRequest is the data needed to request the radar for a rover
-type RadarRequest struct {
+// RotateSecretResponse is the response given to rotating an account secret
+type RotateSecretResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The account for this request
+	// The account information with the new secret
 	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *RadarRequest) Reset() {
-	*x = RadarRequest{}
+func (x *RotateSecretResponse) Reset() {
+	*x = RotateSecretResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -895,13 +936,13 @@ func (x *RadarRequest) Reset() {
 	}
 }
 
-func (x *RadarRequest) String() string {
+func (x *RotateSecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RadarRequest) ProtoMessage() {}
+func (*RotateSecretResponse) ProtoMessage() {}
 
-func (x *RadarRequest) ProtoReflect() protoreflect.Message {
+func (x *RotateSecretResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -913,35 +954,28 @@ func (x *RadarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RadarRequest.ProtoReflect.Descriptor instead.
-func (*RadarRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateSecretResponse) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RadarRequest) GetAccount() *Account {
+func (x *RotateSecretResponse) GetAccount() *Account {
 	if x != nil {
 		return x.Account
 	}
 	return nil
 }
 
-// RadarResponse describes radar information
-type RadarResponse struct {
+// DeleteAccountRequest is an empty placeholder, the account is taken from the
+// request metadata
+type DeleteAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The range in tiles from the rover of the radar data
-	Range int32 `protobuf:"varint,1,opt,name=range,proto3" json:"range,omitempty"`
-	// A 1D array representing range*2 + 1 squared set of tiles, origin bottom
-	// left and in row->column order
-	Tiles []Tile `protobuf:"varint,2,rep,packed,name=tiles,proto3,enum=roveapi.Tile" json:"tiles,omitempty"`
-	// A similar array to the tile array, but containing objects
-	Objects []Object `protobuf:"varint,3,rep,packed,name=objects,proto3,enum=roveapi.Object" json:"objects,omitempty"`
 }
 
-func (x *RadarResponse) Reset() {
-	*x = RadarResponse{}
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,13 +983,13 @@ func (x *RadarResponse) Reset() {
 	}
 }
 
-func (x *RadarResponse) String() string {
+func (x *DeleteAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RadarResponse) ProtoMessage() {}
+func (*DeleteAccountRequest) ProtoMessage() {}
 
-func (x *RadarResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,44 +1001,20 @@ func (x *RadarResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RadarResponse.ProtoReflect.Descriptor instead.
-func (*RadarResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RadarResponse) GetRange() int32 {
-	if x != nil {
-		return x.Range
-	}
-	return 0
-}
-
-func (x *RadarResponse) GetTiles() []Tile {
-	if x != nil {
-		return x.Tiles
-	}
-	return nil
-}
-
-func (x *RadarResponse) GetObjects() []Object {
-	if x != nil {
-		return x.Objects
-	}
-	return nil
-}
-
-// StatusRequest is information needed to request rover status
-type StatusRequest struct {
+// DeleteAccountResponse is an empty placeholder
+type DeleteAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The account for this request
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *StatusRequest) Reset() {
-	*x = StatusRequest{}
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,13 +1022,13 @@ func (x *StatusRequest) Reset() {
 	}
 }
 
-func (x *StatusRequest) String() string {
+func (x *DeleteAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusRequest) ProtoMessage() {}
+func (*DeleteAccountResponse) ProtoMessage() {}
 
-func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1030,32 +1040,43 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
-func (*StatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StatusRequest) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-// Log is a single log item
-type Log struct {
+// Command is a single command for a rover
+type Command struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unix timestamp of the log
-	Time string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	// The text of the log
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// The command type
+	Command CommandType `protobuf:"varint,1,opt,name=command,proto3,enum=roveapi.CommandType" json:"command,omitempty"`
+	// The number of times to repeat the command after the first
+	Repeat int32 `protobuf:"varint,2,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	// broadcast/build - a simple message, must be composed of printable ASCII
+	// glyphs (32-126), up to the server's broadcast length rule (3 by default)
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// move - the bearing for the rover to turn to
+	// drop/build/deposit/withdraw - the bearing of the adjacent tile to use, or
+	// the current tile if unset
+	Bearing Bearing `protobuf:"varint,4,opt,name=bearing,proto3,enum=roveapi.Bearing" json:"bearing,omitempty"`
+	// drop/install/deposit/withdraw - the type of object to use, takes priority
+	// over index
+	// build - the type of structure to build
+	Object Object `protobuf:"varint,6,opt,name=object,proto3,enum=roveapi.Object" json:"object,omitempty"`
+	// drop/install/deposit - the index of the inventory stack to take an object
+	// from, used if no object given
+	Index int32 `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+	// craft - the name of the recipe to craft
+	Recipe string `protobuf:"bytes,8,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// uninstall - the equipment slot to uninstall
+	Slot ComponentSlot `protobuf:"varint,9,opt,name=slot,proto3,enum=roveapi.ComponentSlot" json:"slot,omitempty"`
 }
 
-func (x *Log) Reset() {
-	*x = Log{}
+func (x *Command) Reset() {
+	*x = Command{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1063,13 +1084,13 @@ func (x *Log) Reset() {
 	}
 }
 
-func (x *Log) String() string {
+func (x *Command) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Log) ProtoMessage() {}
+func (*Command) ProtoMessage() {}
 
-func (x *Log) ProtoReflect() protoreflect.Message {
+func (x *Command) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1081,37 +1102,81 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Log.ProtoReflect.Descriptor instead.
-func (*Log) Descriptor() ([]byte, []int) {
+// Deprecated: Use Command.ProtoReflect.Descriptor instead.
+func (*Command) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Log) GetTime() string {
+func (x *Command) GetCommand() CommandType {
 	if x != nil {
-		return x.Time
+		return x.Command
 	}
-	return ""
+	return CommandType_none
 }
 
-func (x *Log) GetText() string {
+func (x *Command) GetRepeat() int32 {
 	if x != nil {
-		return x.Text
+		return x.Repeat
+	}
+	return 0
+}
+
+func (x *Command) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Command) GetBearing() Bearing {
+	if x != nil {
+		return x.Bearing
+	}
+	return Bearing_BearingUnknown
+}
+
+func (x *Command) GetObject() Object {
+	if x != nil {
+		return x.Object
+	}
+	return Object_ObjectUnknown
+}
+
+func (x *Command) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Command) GetRecipe() string {
+	if x != nil {
+		return x.Recipe
 	}
 	return ""
 }
 
-// Vector describes a point or vector in 2D space
-type Vector struct {
+func (x *Command) GetSlot() ComponentSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return ComponentSlot_ComponentSlotUnknown
+}
+
+// CommandRequest describes a set of commands to be requested for the rover
+type CommandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// The set of desired commands
+	Commands []*Command `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
+	// The rover to command, defaults to the first rover in the fleet
+	Rover string `protobuf:"bytes,3,opt,name=rover,proto3" json:"rover,omitempty"`
 }
 
-func (x *Vector) Reset() {
-	*x = Vector{}
+func (x *CommandRequest) Reset() {
+	*x = CommandRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1119,13 +1184,13 @@ func (x *Vector) Reset() {
 	}
 }
 
-func (x *Vector) String() string {
+func (x *CommandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vector) ProtoMessage() {}
+func (*CommandRequest) ProtoMessage() {}
 
-func (x *Vector) ProtoReflect() protoreflect.Message {
+func (x *CommandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1137,44 +1202,34 @@ func (x *Vector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vector.ProtoReflect.Descriptor instead.
-func (*Vector) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommandRequest.ProtoReflect.Descriptor instead.
+func (*CommandRequest) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Vector) GetX() int32 {
+func (x *CommandRequest) GetCommands() []*Command {
 	if x != nil {
-		return x.X
+		return x.Commands
 	}
-	return 0
+	return nil
 }
 
-func (x *Vector) GetY() int32 {
+func (x *CommandRequest) GetRover() string {
 	if x != nil {
-		return x.Y
+		return x.Rover
 	}
-	return 0
+	return ""
 }
 
-type RoverSpecifications struct {
+// CommandResponse is an empty placeholder
+type CommandResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// The name of the rover
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The range of this rover's radar and broadcasting
-	Range int32 `protobuf:"varint,2,opt,name=range,proto3" json:"range,omitempty"`
-	// The capacity of the inventory
-	Capacity int32 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	// The maximum health of the rover
-	MaximumIntegrity int32 `protobuf:"varint,4,opt,name=maximumIntegrity,proto3" json:"maximumIntegrity,omitempty"`
-	// The max energy the rover can store
-	MaximumCharge int32 `protobuf:"varint,5,opt,name=maximumCharge,proto3" json:"maximumCharge,omitempty"`
-}
-
-func (x *RoverSpecifications) Reset() {
-	*x = RoverSpecifications{}
+func (x *CommandResponse) Reset() {
+	*x = CommandResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_roveapi_roveapi_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1182,13 +1237,13 @@ func (x *RoverSpecifications) Reset() {
 	}
 }
 
-func (x *RoverSpecifications) String() string {
+func (x *CommandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoverSpecifications) ProtoMessage() {}
+func (*CommandResponse) ProtoMessage() {}
 
-func (x *RoverSpecifications) ProtoReflect() protoreflect.Message {
+func (x *CommandResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_roveapi_roveapi_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1200,82 +1255,170 @@ func (x *RoverSpecifications) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoverSpecifications.ProtoReflect.Descriptor instead.
-func (*RoverSpecifications) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommandResponse.ProtoReflect.Descriptor instead.
+func (*CommandResponse) Descriptor() ([]byte, []int) {
 	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RoverSpecifications) GetName() string {
+// RadarRequest is the data needed to request the radar for a rover, the
+// account is taken from the request metadata
+type RadarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rover for this request, defaults to the first rover in the fleet
+	Rover string `protobuf:"bytes,2,opt,name=rover,proto3" json:"rover,omitempty"`
+}
+
+func (x *RadarRequest) Reset() {
+	*x = RadarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RadarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RadarRequest) ProtoMessage() {}
+
+func (x *RadarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RadarRequest.ProtoReflect.Descriptor instead.
+func (*RadarRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RadarRequest) GetRover() string {
 	if x != nil {
-		return x.Name
+		return x.Rover
 	}
 	return ""
 }
 
-func (x *RoverSpecifications) GetRange() int32 {
+// RadarResponse describes radar information
+type RadarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The range in tiles from the rover of the radar data
+	Range int32 `protobuf:"varint,1,opt,name=range,proto3" json:"range,omitempty"`
+	// A 1D array representing range*2 + 1 squared set of tiles, origin bottom
+	// left and in row->column order
+	Tiles []Tile `protobuf:"varint,2,rep,packed,name=tiles,proto3,enum=roveapi.Tile" json:"tiles,omitempty"`
+	// A similar array to the tile array, but containing objects
+	Objects []Object `protobuf:"varint,3,rep,packed,name=objects,proto3,enum=roveapi.Object" json:"objects,omitempty"`
+	// The positions of all rovers owned by the account's team, regardless of
+	// range
+	Teammates []*TeamRover `protobuf:"bytes,4,rep,name=teammates,proto3" json:"teammates,omitempty"`
+}
+
+func (x *RadarResponse) Reset() {
+	*x = RadarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RadarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RadarResponse) ProtoMessage() {}
+
+func (x *RadarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RadarResponse.ProtoReflect.Descriptor instead.
+func (*RadarResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RadarResponse) GetRange() int32 {
 	if x != nil {
 		return x.Range
 	}
 	return 0
 }
 
-func (x *RoverSpecifications) GetCapacity() int32 {
+func (x *RadarResponse) GetTiles() []Tile {
 	if x != nil {
-		return x.Capacity
+		return x.Tiles
 	}
-	return 0
+	return nil
 }
 
-func (x *RoverSpecifications) GetMaximumIntegrity() int32 {
+func (x *RadarResponse) GetObjects() []Object {
 	if x != nil {
-		return x.MaximumIntegrity
+		return x.Objects
 	}
-	return 0
+	return nil
 }
 
-func (x *RoverSpecifications) GetMaximumCharge() int32 {
+func (x *RadarResponse) GetTeammates() []*TeamRover {
 	if x != nil {
-		return x.MaximumCharge
+		return x.Teammates
 	}
-	return 0
+	return nil
 }
 
-type RoverStatus struct {
+// TeamRover describes the position of a rover owned by a team member
+type TeamRover struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The current direction of the rover
-	Bearing Bearing `protobuf:"varint,1,opt,name=bearing,proto3,enum=roveapi.Bearing" json:"bearing,omitempty"`
-	// The current position of the sails
-	SailPosition SailPosition `protobuf:"varint,2,opt,name=sailPosition,proto3,enum=roveapi.SailPosition" json:"sailPosition,omitempty"`
-	// The items in the rover inventory
-	Inventory []byte `protobuf:"bytes,3,opt,name=inventory,proto3" json:"inventory,omitempty"`
-	// The current health of the rover
-	Integrity int32 `protobuf:"varint,4,opt,name=integrity,proto3" json:"integrity,omitempty"`
-	// The energy stored in the rover
-	Charge int32 `protobuf:"varint,5,opt,name=charge,proto3" json:"charge,omitempty"`
-	// The set of currently queued commands
-	QueuedCommands []*Command `protobuf:"bytes,6,rep,name=queuedCommands,proto3" json:"queuedCommands,omitempty"`
+	// The name of the rover
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The account that owns the rover
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// Position of the rover in world coordinates
+	Position *Vector `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *RoverStatus) Reset() {
-	*x = RoverStatus{}
+func (x *TeamRover) Reset() {
+	*x = TeamRover{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_roveapi_roveapi_proto_msgTypes[14]
+		mi := &file_roveapi_roveapi_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoverStatus) String() string {
+func (x *TeamRover) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoverStatus) ProtoMessage() {}
+func (*TeamRover) ProtoMessage() {}
 
-func (x *RoverStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_roveapi_roveapi_proto_msgTypes[14]
+func (x *TeamRover) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,449 +1429,2360 @@ func (x *RoverStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoverStatus.ProtoReflect.Descriptor instead.
-func (*RoverStatus) Descriptor() ([]byte, []int) {
-	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use TeamRover.ProtoReflect.Descriptor instead.
+func (*TeamRover) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RoverStatus) GetBearing() Bearing {
+func (x *TeamRover) GetName() string {
 	if x != nil {
-		return x.Bearing
+		return x.Name
 	}
-	return Bearing_BearingUnknown
+	return ""
 }
 
-func (x *RoverStatus) GetSailPosition() SailPosition {
+func (x *TeamRover) GetAccount() string {
 	if x != nil {
-		return x.SailPosition
+		return x.Account
 	}
-	return SailPosition_UnknownSailPosition
+	return ""
 }
 
-func (x *RoverStatus) GetInventory() []byte {
+func (x *TeamRover) GetPosition() *Vector {
 	if x != nil {
-		return x.Inventory
+		return x.Position
 	}
 	return nil
 }
 
-func (x *RoverStatus) GetIntegrity() int32 {
-	if x != nil {
-		return x.Integrity
+// StatusRequest is information needed to request rover status, the account
+// is taken from the request metadata
+type StatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rover for this request, defaults to the first rover in the fleet
+	Rover string `protobuf:"bytes,2,opt,name=rover,proto3" json:"rover,omitempty"`
+}
+
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *RoverStatus) GetCharge() int32 {
-	if x != nil {
-		return x.Charge
+func (x *StatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusRequest) ProtoMessage() {}
+
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RoverStatus) GetQueuedCommands() []*Command {
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StatusRequest) GetRover() string {
 	if x != nil {
-		return x.QueuedCommands
+		return x.Rover
 	}
-	return nil
+	return ""
 }
 
-type RoverReadings struct {
+// Log is a single log item
+type Log struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Position of the rover in world coordinates
-	Position *Vector `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	// The current wind direction
-	Wind Bearing `protobuf:"varint,2,opt,name=wind,proto3,enum=roveapi.Bearing" json:"wind,omitempty"`
-	// The most recent logs
-	Logs []*Log `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	// The unix timestamp of the log
+	Time string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The text of the log
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *RoverReadings) Reset() {
-	*x = RoverReadings{}
+func (x *Log) Reset() {
+	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_roveapi_roveapi_proto_msgTypes[15]
+		mi := &file_roveapi_roveapi_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoverReadings) String() string {
+func (x *Log) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoverReadings) ProtoMessage() {}
+func (*Log) ProtoMessage() {}
 
-func (x *RoverReadings) ProtoReflect() protoreflect.Message {
-	mi := &file_roveapi_roveapi_proto_msgTypes[15]
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RoverReadings.ProtoReflect.Descriptor instead.
-func (*RoverReadings) Descriptor() ([]byte, []int) {
-	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *RoverReadings) GetPosition() *Vector {
-	if x != nil {
-		return x.Position
-	}
-	return nil
-}
-
-func (x *RoverReadings) GetWind() Bearing {
-	if x != nil {
-		return x.Wind
-	}
-	return Bearing_BearingUnknown
-}
-
-func (x *RoverReadings) GetLogs() []*Log {
-	if x != nil {
-		return x.Logs
-	}
-	return nil
-}
-
-// StatusResponse is the response given to a status request
-type StatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The static rover information
-	Spec *RoverSpecifications `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	// Current rover status
-	Status *RoverStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	// Current rover readings
-	Readings *RoverReadings `protobuf:"bytes,3,opt,name=readings,proto3" json:"readings,omitempty"`
-}
-
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_roveapi_roveapi_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *StatusResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StatusResponse) ProtoMessage() {}
-
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_roveapi_roveapi_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Log) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Log) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Vector describes a point or vector in 2D space
+type Vector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Vector) Reset() {
+	*x = Vector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector) ProtoMessage() {}
+
+func (x *Vector) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector.ProtoReflect.Descriptor instead.
+func (*Vector) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Vector) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vector) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// InstalledComponent describes a component installed in an equipment slot
+type InstalledComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The equipment slot
+	Slot ComponentSlot `protobuf:"varint,1,opt,name=slot,proto3,enum=roveapi.ComponentSlot" json:"slot,omitempty"`
+	// The component installed in the slot
+	Object Object `protobuf:"varint,2,opt,name=object,proto3,enum=roveapi.Object" json:"object,omitempty"`
+}
+
+func (x *InstalledComponent) Reset() {
+	*x = InstalledComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledComponent) ProtoMessage() {}
+
+func (x *InstalledComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledComponent.ProtoReflect.Descriptor instead.
+func (*InstalledComponent) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InstalledComponent) GetSlot() ComponentSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return ComponentSlot_ComponentSlotUnknown
+}
+
+func (x *InstalledComponent) GetObject() Object {
+	if x != nil {
+		return x.Object
+	}
+	return Object_ObjectUnknown
+}
+
+type RoverSpecifications struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the rover
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The range of this rover's radar and broadcasting
+	Range int32 `protobuf:"varint,2,opt,name=range,proto3" json:"range,omitempty"`
+	// The maximum total weight the inventory can hold
+	Capacity int32 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// The maximum health of the rover
+	MaximumIntegrity int32 `protobuf:"varint,4,opt,name=maximumIntegrity,proto3" json:"maximumIntegrity,omitempty"`
+	// The max energy the rover can store
+	MaximumCharge int32 `protobuf:"varint,5,opt,name=maximumCharge,proto3" json:"maximumCharge,omitempty"`
+	// The components currently installed, which the above specifications are
+	// derived from
+	Loadout []*InstalledComponent `protobuf:"bytes,6,rep,name=loadout,proto3" json:"loadout,omitempty"`
+}
+
+func (x *RoverSpecifications) Reset() {
+	*x = RoverSpecifications{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoverSpecifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoverSpecifications) ProtoMessage() {}
+
+func (x *RoverSpecifications) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoverSpecifications.ProtoReflect.Descriptor instead.
+func (*RoverSpecifications) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RoverSpecifications) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoverSpecifications) GetRange() int32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *RoverSpecifications) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *RoverSpecifications) GetMaximumIntegrity() int32 {
+	if x != nil {
+		return x.MaximumIntegrity
+	}
+	return 0
+}
+
+func (x *RoverSpecifications) GetMaximumCharge() int32 {
+	if x != nil {
+		return x.MaximumCharge
+	}
+	return 0
+}
+
+func (x *RoverSpecifications) GetLoadout() []*InstalledComponent {
+	if x != nil {
+		return x.Loadout
+	}
+	return nil
+}
+
+// InventoryItem describes a stack of identical objects in the rover inventory
+type InventoryItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the objects in this stack
+	Object Object `protobuf:"varint,1,opt,name=object,proto3,enum=roveapi.Object" json:"object,omitempty"`
+	// The number of objects in this stack
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// The inventory weight of a single object in this stack
+	Weight int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Any additional data carried by the objects in this stack
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InventoryItem) Reset() {
+	*x = InventoryItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InventoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryItem) ProtoMessage() {}
+
+func (x *InventoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
+func (*InventoryItem) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InventoryItem) GetObject() Object {
+	if x != nil {
+		return x.Object
+	}
+	return Object_ObjectUnknown
+}
+
+func (x *InventoryItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *InventoryItem) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RoverStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current direction of the rover
+	Bearing Bearing `protobuf:"varint,1,opt,name=bearing,proto3,enum=roveapi.Bearing" json:"bearing,omitempty"`
+	// The current position of the sails
+	SailPosition SailPosition `protobuf:"varint,2,opt,name=sailPosition,proto3,enum=roveapi.SailPosition" json:"sailPosition,omitempty"`
+	// The current health of the rover
+	Integrity int32 `protobuf:"varint,4,opt,name=integrity,proto3" json:"integrity,omitempty"`
+	// The energy stored in the rover
+	Charge int32 `protobuf:"varint,5,opt,name=charge,proto3" json:"charge,omitempty"`
+	// The set of currently queued commands
+	QueuedCommands []*Command `protobuf:"bytes,6,rep,name=queuedCommands,proto3" json:"queuedCommands,omitempty"`
+	// The stacks of items in the rover inventory
+	Inventory []*InventoryItem `protobuf:"bytes,7,rep,name=inventory,proto3" json:"inventory,omitempty"`
+	// The current total weight of the inventory
+	InventoryWeight int32 `protobuf:"varint,8,opt,name=inventoryWeight,proto3" json:"inventoryWeight,omitempty"`
+	// The recipe currently being crafted, if any
+	Crafting string `protobuf:"bytes,9,opt,name=crafting,proto3" json:"crafting,omitempty"`
+	// The number of ticks left until crafting completes
+	CraftingTicks int32 `protobuf:"varint,10,opt,name=craftingTicks,proto3" json:"craftingTicks,omitempty"`
+}
+
+func (x *RoverStatus) Reset() {
+	*x = RoverStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoverStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoverStatus) ProtoMessage() {}
+
+func (x *RoverStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoverStatus.ProtoReflect.Descriptor instead.
+func (*RoverStatus) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RoverStatus) GetBearing() Bearing {
+	if x != nil {
+		return x.Bearing
+	}
+	return Bearing_BearingUnknown
+}
+
+func (x *RoverStatus) GetSailPosition() SailPosition {
+	if x != nil {
+		return x.SailPosition
+	}
+	return SailPosition_UnknownSailPosition
+}
+
+func (x *RoverStatus) GetIntegrity() int32 {
+	if x != nil {
+		return x.Integrity
+	}
+	return 0
+}
+
+func (x *RoverStatus) GetCharge() int32 {
+	if x != nil {
+		return x.Charge
+	}
+	return 0
+}
+
+func (x *RoverStatus) GetQueuedCommands() []*Command {
+	if x != nil {
+		return x.QueuedCommands
+	}
+	return nil
+}
+
+func (x *RoverStatus) GetInventory() []*InventoryItem {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+func (x *RoverStatus) GetInventoryWeight() int32 {
+	if x != nil {
+		return x.InventoryWeight
+	}
+	return 0
+}
+
+func (x *RoverStatus) GetCrafting() string {
+	if x != nil {
+		return x.Crafting
+	}
+	return ""
+}
+
+func (x *RoverStatus) GetCraftingTicks() int32 {
+	if x != nil {
+		return x.CraftingTicks
+	}
+	return 0
+}
+
+type RoverReadings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position of the rover in world coordinates
+	Position *Vector `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// The current wind direction
+	Wind Bearing `protobuf:"varint,2,opt,name=wind,proto3,enum=roveapi.Bearing" json:"wind,omitempty"`
+	// The most recent logs
+	Logs []*Log `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *RoverReadings) Reset() {
+	*x = RoverReadings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoverReadings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoverReadings) ProtoMessage() {}
+
+func (x *RoverReadings) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoverReadings.ProtoReflect.Descriptor instead.
+func (*RoverReadings) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RoverReadings) GetPosition() *Vector {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *RoverReadings) GetWind() Bearing {
+	if x != nil {
+		return x.Wind
+	}
+	return Bearing_BearingUnknown
+}
+
+func (x *RoverReadings) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// StatusResponse is the response given to a status request
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The static rover information
+	Spec *RoverSpecifications `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Current rover status
+	Status *RoverStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Current rover readings
+	Readings *RoverReadings `protobuf:"bytes,3,opt,name=readings,proto3" json:"readings,omitempty"`
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StatusResponse) GetSpec() *RoverSpecifications {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetStatus() *RoverStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetReadings() *RoverReadings {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+// FleetRequest is an empty placeholder, the account is taken from the request
+// metadata
+type FleetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FleetRequest) Reset() {
+	*x = FleetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetRequest) ProtoMessage() {}
+
+func (x *FleetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetRequest.ProtoReflect.Descriptor instead.
+func (*FleetRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{26}
+}
+
+// FleetResponse describes the rovers owned by an account
+type FleetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The names of all live rovers owned by the account
+	Rovers []string `protobuf:"bytes,1,rep,name=rovers,proto3" json:"rovers,omitempty"`
+	// The maximum number of rovers the account can own, 0 means no limit
+	Cap int32 `protobuf:"varint,2,opt,name=cap,proto3" json:"cap,omitempty"`
+}
+
+func (x *FleetResponse) Reset() {
+	*x = FleetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetResponse) ProtoMessage() {}
+
+func (x *FleetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetResponse.ProtoReflect.Descriptor instead.
+func (*FleetResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FleetResponse) GetRovers() []string {
+	if x != nil {
+		return x.Rovers
+	}
+	return nil
+}
+
+func (x *FleetResponse) GetCap() int32 {
+	if x != nil {
+		return x.Cap
+	}
+	return 0
+}
+
+// CreateTeamRequest contains the data to create a team
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The desired team name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// JoinTeamRequest contains the data to join a team
+type JoinTeamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The team to join
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *JoinTeamRequest) Reset() {
+	*x = JoinTeamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinTeamRequest) ProtoMessage() {}
+
+func (x *JoinTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinTeamRequest.ProtoReflect.Descriptor instead.
+func (*JoinTeamRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *JoinTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// TeamResponse describes the account's team
+type TeamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The team name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The accounts in the team
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *TeamResponse) Reset() {
+	*x = TeamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamResponse) ProtoMessage() {}
+
+func (x *TeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamResponse.ProtoReflect.Descriptor instead.
+func (*TeamResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TeamResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// LeaveTeamRequest is an empty placeholder, the account is taken from the
+// request metadata
+type LeaveTeamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveTeamRequest) Reset() {
+	*x = LeaveTeamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTeamRequest) ProtoMessage() {}
+
+func (x *LeaveTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTeamRequest.ProtoReflect.Descriptor instead.
+func (*LeaveTeamRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{31}
+}
+
+// LeaveTeamResponse is an empty placeholder
+type LeaveTeamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveTeamResponse) Reset() {
+	*x = LeaveTeamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTeamResponse) ProtoMessage() {}
+
+func (x *LeaveTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTeamResponse.ProtoReflect.Descriptor instead.
+func (*LeaveTeamResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{32}
+}
+
+// TeamMessageRequest contains a message for the team
+type TeamMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The message, must be composed of up to 64 printable ASCII glyphs (32-126)
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TeamMessageRequest) Reset() {
+	*x = TeamMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMessageRequest) ProtoMessage() {}
+
+func (x *TeamMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMessageRequest.ProtoReflect.Descriptor instead.
+func (*TeamMessageRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TeamMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TeamMessageResponse is an empty placeholder
+type TeamMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TeamMessageResponse) Reset() {
+	*x = TeamMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMessageResponse) ProtoMessage() {}
+
+func (x *TeamMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMessageResponse.ProtoReflect.Descriptor instead.
+func (*TeamMessageResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{34}
+}
+
+// MapRequest is the data needed to request the explored map around a rover
+type MapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rover to centre the map on, defaults to the first rover in the fleet
+	Rover string `protobuf:"bytes,1,opt,name=rover,proto3" json:"rover,omitempty"`
+	// The range in tiles from the rover to include, up to 32
+	Range int32 `protobuf:"varint,2,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *MapRequest) Reset() {
+	*x = MapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapRequest) ProtoMessage() {}
+
+func (x *MapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapRequest.ProtoReflect.Descriptor instead.
+func (*MapRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MapRequest) GetRover() string {
+	if x != nil {
+		return x.Rover
+	}
+	return ""
+}
+
+func (x *MapRequest) GetRange() int32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+// MapResponse describes the explored map around a rover
+type MapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The range in tiles from the rover of the map data
+	Range int32 `protobuf:"varint,1,opt,name=range,proto3" json:"range,omitempty"`
+	// A 1D array representing range*2 + 1 squared set of tiles, origin bottom
+	// left and in row->column order, unexplored tiles are TileUnknown
+	Tiles []Tile `protobuf:"varint,2,rep,packed,name=tiles,proto3,enum=roveapi.Tile" json:"tiles,omitempty"`
+	// A similar array to the tile array, but containing objects
+	Objects []Object `protobuf:"varint,3,rep,packed,name=objects,proto3,enum=roveapi.Object" json:"objects,omitempty"`
+}
+
+func (x *MapResponse) Reset() {
+	*x = MapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapResponse) ProtoMessage() {}
+
+func (x *MapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapResponse.ProtoReflect.Descriptor instead.
+func (*MapResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MapResponse) GetRange() int32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *MapResponse) GetTiles() []Tile {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *MapResponse) GetObjects() []Object {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+// SetVisibilityRequest sets whether the account is shown to spectators
+type SetVisibilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the account's rovers are shown to spectators
+	Public bool `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+}
+
+func (x *SetVisibilityRequest) Reset() {
+	*x = SetVisibilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVisibilityRequest) ProtoMessage() {}
+
+func (x *SetVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*SetVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetVisibilityRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+// SetVisibilityResponse is the response to a visibility change
+type SetVisibilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetVisibilityResponse) Reset() {
+	*x = SetVisibilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVisibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVisibilityResponse) ProtoMessage() {}
+
+func (x *SetVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*SetVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{38}
+}
+
+// SpectateRequest describes the region of the world to view
+type SpectateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The centre of the view in world coordinates
+	Centre *Vector `protobuf:"bytes,1,opt,name=centre,proto3" json:"centre,omitempty"`
+	// The range in tiles from the centre to include
+	Range int32 `protobuf:"varint,2,opt,name=range,proto3" json:"range,omitempty"`
+	// The width in tiles of the square each sample covers, 0 is treated as 1
+	Scale int32 `protobuf:"varint,3,opt,name=scale,proto3" json:"scale,omitempty"`
+}
+
+func (x *SpectateRequest) Reset() {
+	*x = SpectateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRequest) ProtoMessage() {}
+
+func (x *SpectateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRequest.ProtoReflect.Descriptor instead.
+func (*SpectateRequest) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SpectateRequest) GetCentre() *Vector {
+	if x != nil {
+		return x.Centre
+	}
+	return nil
+}
+
+func (x *SpectateRequest) GetRange() int32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *SpectateRequest) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+// SpectatorRover describes a rover shown to spectators
+type SpectatorRover struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the rover
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The account that owns the rover
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// Position of the rover in world coordinates
+	Position *Vector `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *SpectatorRover) Reset() {
+	*x = SpectatorRover{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectatorRover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorRover) ProtoMessage() {}
+
+func (x *SpectatorRover) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorRover.ProtoReflect.Descriptor instead.
+func (*SpectatorRover) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SpectatorRover) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpectatorRover) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *SpectatorRover) GetPosition() *Vector {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+// SpectateResponse is a downsampled view of the world
+type SpectateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tick the view was taken at
+	Tick int32 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// The range in tiles from the centre of the view
+	Range int32 `protobuf:"varint,2,opt,name=range,proto3" json:"range,omitempty"`
+	// The width in tiles of the square each sample covers
+	Scale int32 `protobuf:"varint,3,opt,name=scale,proto3" json:"scale,omitempty"`
+	// The number of samples along each side of the view
+	Size int32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// A 1D array representing size squared samples, origin bottom left and in
+	// row->column order, each the tile at the centre of its square, tiles that
+	// haven't been generated yet are TileUnknown
+	Tiles []Tile `protobuf:"varint,5,rep,packed,name=tiles,proto3,enum=roveapi.Tile" json:"tiles,omitempty"`
+	// A similar array to the tile array, but containing objects, rovers are
+	// only listed in the rovers field
+	Objects []Object `protobuf:"varint,6,rep,packed,name=objects,proto3,enum=roveapi.Object" json:"objects,omitempty"`
+	// The rovers in the view that spectators can see
+	Rovers []*SpectatorRover `protobuf:"bytes,7,rep,name=rovers,proto3" json:"rovers,omitempty"`
+}
+
+func (x *SpectateResponse) Reset() {
+	*x = SpectateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roveapi_roveapi_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateResponse) ProtoMessage() {}
+
+func (x *SpectateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roveapi_roveapi_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateResponse.ProtoReflect.Descriptor instead.
+func (*SpectateResponse) Descriptor() ([]byte, []int) {
+	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SpectateResponse) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *SpectateResponse) GetRange() int32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *SpectateResponse) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *SpectateResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SpectateResponse) GetTiles() []Tile {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *SpectateResponse) GetObjects() []Object {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *SpectateResponse) GetRovers() []*SpectatorRover {
+	if x != nil {
+		return x.Rovers
+	}
+	return nil
+}
+
+var File_roveapi_roveapi_proto protoreflect.FileDescriptor
+
+var file_roveapi_roveapi_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69,
+	0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35,
+	0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x1b, 0x0a, 0x13, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08,
+	0x01, 0x10, 0x02, 0x22, 0x42, 0x0a, 0x14, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
+	0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x2a, 0x0a, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x06,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72,
+	0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x5a, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10,
+	0x02, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x0c, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x22, 0xa7, 0x01, 0x0a, 0x0d, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d,
+	0x6d, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x6f,
+	0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x09, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x09, 0x54, 0x65,
+	0x61, 0x6d, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70,
+	0x69, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22,
+	0x2d, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x24,
+	0x0a, 0x06, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x79, 0x22, 0x69, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0xe4, 0x01, 0x0a, 0x13, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a,
+	0x10, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12,
+	0x35, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x6c,
+	0x6f, 0x61, 0x64, 0x6f, 0x75, 0x74, 0x22, 0x7a, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70,
+	0x69, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x8c, 0x03, 0x0a, 0x0b, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x39,
+	0x0a, 0x0c, 0x73, 0x61, 0x69, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x61, 0x69, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x61, 0x69,
+	0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12,
+	0x38, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72,
+	0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x61,
+	0x66, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x61,
+	0x66, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x61, 0x66, 0x74, 0x69, 0x6e,
+	0x67, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x72,
+	0x61, 0x66, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10,
+	0x04, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x76, 0x65,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x2c, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x0e, 0x0a, 0x0c, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x39, 0x0a, 0x0d, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x61, 0x70, 0x22, 0x27, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x54, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2e, 0x0a, 0x12, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x15, 0x0a, 0x13, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x22, 0x73, 0x0a, 0x0b, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x66, 0x0a, 0x0f, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x6b, 0x0a, 0x0e, 0x53, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe7, 0x01, 0x0a, 0x10, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x23,
+	0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2f,
+	0x0a, 0x06, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x06, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x2a,
+	0xe0, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x77, 0x61, 0x69,
+	0x74, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x73, 0x68, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x10, 0x05,
+	0x12, 0x0d, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x10, 0x06, 0x12,
+	0x0b, 0x0a, 0x07, 0x73, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x64, 0x72,
+	0x6f, 0x70, 0x10, 0x0a, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x72, 0x61, 0x66, 0x74, 0x10, 0x0b, 0x12,
+	0x0b, 0x0a, 0x07, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x10, 0x0c, 0x12, 0x0d, 0x0a, 0x09,
+	0x75, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x10, 0x0d, 0x12, 0x09, 0x0a, 0x05, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x10, 0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x10, 0x0f, 0x12, 0x0c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x10,
+	0x10, 0x12, 0x09, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x10, 0x11, 0x22, 0x04, 0x08, 0x09,
+	0x10, 0x09, 0x2a, 0x83, 0x01, 0x0a, 0x07, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12,
+	0x0a, 0x0e, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x45, 0x61, 0x73, 0x74, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x45, 0x61, 0x73, 0x74, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x74, 0x68, 0x45,
+	0x61, 0x73, 0x74, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x6f, 0x75, 0x74, 0x68, 0x10, 0x05,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x74, 0x68, 0x57, 0x65, 0x73, 0x74, 0x10, 0x06, 0x12,
+	0x08, 0x0a, 0x04, 0x57, 0x65, 0x73, 0x74, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x6f, 0x72,
+	0x74, 0x68, 0x57, 0x65, 0x73, 0x74, 0x10, 0x08, 0x2a, 0x62, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x6e, 0x74, 0x65, 0x6e, 0x6e, 0x61, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x48, 0x75, 0x6c, 0x6c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x61, 0x69, 0x6c, 0x10,
+	0x04, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x10, 0x05, 0x2a, 0xe5, 0x01, 0x0a,
+	0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x6f,
+	0x76, 0x65, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x6f, 0x76,
+	0x65, 0x72, 0x44, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x52,
+	0x6f, 0x63, 0x6b, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x6f,
+	0x63, 0x6b, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x73, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x6f, 0x6c,
+	0x61, 0x72, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x6e, 0x74,
+	0x65, 0x6e, 0x6e, 0x61, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x10, 0x07, 0x12, 0x0d, 0x0a,
+	0x09, 0x48, 0x75, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b,
+	0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x65, 0x6c, 0x6c, 0x10, 0x09, 0x12, 0x0c, 0x0a,
+	0x08, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x42, 0x69, 0x74, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x10, 0x0b, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x6f, 0x6c, 0x61, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x0c, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x10, 0x0d, 0x2a, 0x37, 0x0a, 0x04, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x0a, 0x0b,
+	0x54, 0x69, 0x6c, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x52, 0x6f, 0x63, 0x6b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x76, 0x65,
+	0x6c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x61, 0x6e, 0x64, 0x10, 0x03, 0x2a, 0x4c, 0x0a,
+	0x0c, 0x53, 0x61, 0x69, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x13, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x61, 0x69, 0x6c, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x6f, 0x6c, 0x61,
+	0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x32, 0x8b, 0x09, 0x0a, 0x04,
+	0x52, 0x6f, 0x76, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x18, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x6f, 0x76, 0x65,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x15, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x0c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x17, 0x2e, 0x72,
+	0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x38, 0x0a, 0x05, 0x52, 0x61, 0x64, 0x61, 0x72, 0x12, 0x15, 0x2e, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x61, 0x64, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x05, 0x46, 0x6c, 0x65, 0x65,
+	0x74, 0x12, 0x15, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6c, 0x65, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61,
+	0x70, 0x69, 0x2e, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72,
+	0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x65, 0x61,
+	0x6d, 0x12, 0x18, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x6f,
+	0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x12, 0x19, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72,
+	0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x54, 0x65,
+	0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x72, 0x6f, 0x76, 0x65,
+	0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x13, 0x2e,
+	0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x53, 0x65,
+	0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x72, 0x6f,
+	0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x6f, 0x76,
+	0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x64, 0x69, 0x6c, 0x75, 0x7a, 0x2f, 0x72,
+	0x6f, 0x76, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_roveapi_roveapi_proto_rawDescOnce sync.Once
+	file_roveapi_roveapi_proto_rawDescData = file_roveapi_roveapi_proto_rawDesc
+)
+
+func file_roveapi_roveapi_proto_rawDescGZIP() []byte {
+	file_roveapi_roveapi_proto_rawDescOnce.Do(func() {
+		file_roveapi_roveapi_proto_rawDescData = protoimpl.X.CompressGZIP(file_roveapi_roveapi_proto_rawDescData)
+	})
+	return file_roveapi_roveapi_proto_rawDescData
+}
+
+var file_roveapi_roveapi_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_roveapi_roveapi_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_roveapi_roveapi_proto_goTypes = []interface{}{
+	(CommandType)(0),              // 0: roveapi.CommandType
+	(Bearing)(0),                  // 1: roveapi.Bearing
+	(ComponentSlot)(0),            // 2: roveapi.ComponentSlot
+	(Object)(0),                   // 3: roveapi.Object
+	(Tile)(0),                     // 4: roveapi.Tile
+	(SailPosition)(0),             // 5: roveapi.SailPosition
+	(*ServerStatusRequest)(nil),   // 6: roveapi.ServerStatusRequest
+	(*ServerStatusResponse)(nil),  // 7: roveapi.ServerStatusResponse
+	(*RegisterRequest)(nil),       // 8: roveapi.RegisterRequest
+	(*Account)(nil),               // 9: roveapi.Account
+	(*RegisterResponse)(nil),      // 10: roveapi.RegisterResponse
+	(*LoginRequest)(nil),          // 11: roveapi.LoginRequest
+	(*LoginResponse)(nil),         // 12: roveapi.LoginResponse
+	(*RotateSecretRequest)(nil),   // 13: roveapi.RotateSecretRequest
+	(*RotateSecretResponse)(nil),  // 14: roveapi.RotateSecretResponse
+	(*DeleteAccountRequest)(nil),  // 15: roveapi.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil), // 16: roveapi.DeleteAccountResponse
+	(*Command)(nil),               // 17: roveapi.Command
+	(*CommandRequest)(nil),        // 18: roveapi.CommandRequest
+	(*CommandResponse)(nil),       // 19: roveapi.CommandResponse
+	(*RadarRequest)(nil),          // 20: roveapi.RadarRequest
+	(*RadarResponse)(nil),         // 21: roveapi.RadarResponse
+	(*TeamRover)(nil),             // 22: roveapi.TeamRover
+	(*StatusRequest)(nil),         // 23: roveapi.StatusRequest
+	(*Log)(nil),                   // 24: roveapi.Log
+	(*Vector)(nil),                // 25: roveapi.Vector
+	(*InstalledComponent)(nil),    // 26: roveapi.InstalledComponent
+	(*RoverSpecifications)(nil),   // 27: roveapi.RoverSpecifications
+	(*InventoryItem)(nil),         // 28: roveapi.InventoryItem
+	(*RoverStatus)(nil),           // 29: roveapi.RoverStatus
+	(*RoverReadings)(nil),         // 30: roveapi.RoverReadings
+	(*StatusResponse)(nil),        // 31: roveapi.StatusResponse
+	(*FleetRequest)(nil),          // 32: roveapi.FleetRequest
+	(*FleetResponse)(nil),         // 33: roveapi.FleetResponse
+	(*CreateTeamRequest)(nil),     // 34: roveapi.CreateTeamRequest
+	(*JoinTeamRequest)(nil),       // 35: roveapi.JoinTeamRequest
+	(*TeamResponse)(nil),          // 36: roveapi.TeamResponse
+	(*LeaveTeamRequest)(nil),      // 37: roveapi.LeaveTeamRequest
+	(*LeaveTeamResponse)(nil),     // 38: roveapi.LeaveTeamResponse
+	(*TeamMessageRequest)(nil),    // 39: roveapi.TeamMessageRequest
+	(*TeamMessageResponse)(nil),   // 40: roveapi.TeamMessageResponse
+	(*MapRequest)(nil),            // 41: roveapi.MapRequest
+	(*MapResponse)(nil),           // 42: roveapi.MapResponse
+	(*SetVisibilityRequest)(nil),  // 43: roveapi.SetVisibilityRequest
+	(*SetVisibilityResponse)(nil), // 44: roveapi.SetVisibilityResponse
+	(*SpectateRequest)(nil),       // 45: roveapi.SpectateRequest
+	(*SpectatorRover)(nil),        // 46: roveapi.SpectatorRover
+	(*SpectateResponse)(nil),      // 47: roveapi.SpectateResponse
+}
+var file_roveapi_roveapi_proto_depIdxs = []int32{
+	9,  // 0: roveapi.RegisterResponse.account:type_name -> roveapi.Account
+	9,  // 1: roveapi.RotateSecretResponse.account:type_name -> roveapi.Account
+	0,  // 2: roveapi.Command.command:type_name -> roveapi.CommandType
+	1,  // 3: roveapi.Command.bearing:type_name -> roveapi.Bearing
+	3,  // 4: roveapi.Command.object:type_name -> roveapi.Object
+	2,  // 5: roveapi.Command.slot:type_name -> roveapi.ComponentSlot
+	17, // 6: roveapi.CommandRequest.commands:type_name -> roveapi.Command
+	4,  // 7: roveapi.RadarResponse.tiles:type_name -> roveapi.Tile
+	3,  // 8: roveapi.RadarResponse.objects:type_name -> roveapi.Object
+	22, // 9: roveapi.RadarResponse.teammates:type_name -> roveapi.TeamRover
+	25, // 10: roveapi.TeamRover.position:type_name -> roveapi.Vector
+	2,  // 11: roveapi.InstalledComponent.slot:type_name -> roveapi.ComponentSlot
+	3,  // 12: roveapi.InstalledComponent.object:type_name -> roveapi.Object
+	26, // 13: roveapi.RoverSpecifications.loadout:type_name -> roveapi.InstalledComponent
+	3,  // 14: roveapi.InventoryItem.object:type_name -> roveapi.Object
+	1,  // 15: roveapi.RoverStatus.bearing:type_name -> roveapi.Bearing
+	5,  // 16: roveapi.RoverStatus.sailPosition:type_name -> roveapi.SailPosition
+	17, // 17: roveapi.RoverStatus.queuedCommands:type_name -> roveapi.Command
+	28, // 18: roveapi.RoverStatus.inventory:type_name -> roveapi.InventoryItem
+	25, // 19: roveapi.RoverReadings.position:type_name -> roveapi.Vector
+	1,  // 20: roveapi.RoverReadings.wind:type_name -> roveapi.Bearing
+	24, // 21: roveapi.RoverReadings.logs:type_name -> roveapi.Log
+	27, // 22: roveapi.StatusResponse.spec:type_name -> roveapi.RoverSpecifications
+	29, // 23: roveapi.StatusResponse.status:type_name -> roveapi.RoverStatus
+	30, // 24: roveapi.StatusResponse.readings:type_name -> roveapi.RoverReadings
+	4,  // 25: roveapi.MapResponse.tiles:type_name -> roveapi.Tile
+	3,  // 26: roveapi.MapResponse.objects:type_name -> roveapi.Object
+	25, // 27: roveapi.SpectateRequest.centre:type_name -> roveapi.Vector
+	25, // 28: roveapi.SpectatorRover.position:type_name -> roveapi.Vector
+	4,  // 29: roveapi.SpectateResponse.tiles:type_name -> roveapi.Tile
+	3,  // 30: roveapi.SpectateResponse.objects:type_name -> roveapi.Object
+	46, // 31: roveapi.SpectateResponse.rovers:type_name -> roveapi.SpectatorRover
+	6,  // 32: roveapi.Rove.ServerStatus:input_type -> roveapi.ServerStatusRequest
+	8,  // 33: roveapi.Rove.Register:input_type -> roveapi.RegisterRequest
+	11, // 34: roveapi.Rove.Login:input_type -> roveapi.LoginRequest
+	13, // 35: roveapi.Rove.RotateSecret:input_type -> roveapi.RotateSecretRequest
+	15, // 36: roveapi.Rove.DeleteAccount:input_type -> roveapi.DeleteAccountRequest
+	18, // 37: roveapi.Rove.Command:input_type -> roveapi.CommandRequest
+	20, // 38: roveapi.Rove.Radar:input_type -> roveapi.RadarRequest
+	23, // 39: roveapi.Rove.Status:input_type -> roveapi.StatusRequest
+	32, // 40: roveapi.Rove.Fleet:input_type -> roveapi.FleetRequest
+	34, // 41: roveapi.Rove.CreateTeam:input_type -> roveapi.CreateTeamRequest
+	35, // 42: roveapi.Rove.JoinTeam:input_type -> roveapi.JoinTeamRequest
+	37, // 43: roveapi.Rove.LeaveTeam:input_type -> roveapi.LeaveTeamRequest
+	39, // 44: roveapi.Rove.TeamMessage:input_type -> roveapi.TeamMessageRequest
+	41, // 45: roveapi.Rove.Map:input_type -> roveapi.MapRequest
+	43, // 46: roveapi.Rove.SetVisibility:input_type -> roveapi.SetVisibilityRequest
+	45, // 47: roveapi.Rove.Spectate:input_type -> roveapi.SpectateRequest
+	45, // 48: roveapi.Rove.WatchSpectate:input_type -> roveapi.SpectateRequest
+	7,  // 49: roveapi.Rove.ServerStatus:output_type -> roveapi.ServerStatusResponse
+	10, // 50: roveapi.Rove.Register:output_type -> roveapi.RegisterResponse
+	12, // 51: roveapi.Rove.Login:output_type -> roveapi.LoginResponse
+	14, // 52: roveapi.Rove.RotateSecret:output_type -> roveapi.RotateSecretResponse
+	16, // 53: roveapi.Rove.DeleteAccount:output_type -> roveapi.DeleteAccountResponse
+	19, // 54: roveapi.Rove.Command:output_type -> roveapi.CommandResponse
+	21, // 55: roveapi.Rove.Radar:output_type -> roveapi.RadarResponse
+	31, // 56: roveapi.Rove.Status:output_type -> roveapi.StatusResponse
+	33, // 57: roveapi.Rove.Fleet:output_type -> roveapi.FleetResponse
+	36, // 58: roveapi.Rove.CreateTeam:output_type -> roveapi.TeamResponse
+	36, // 59: roveapi.Rove.JoinTeam:output_type -> roveapi.TeamResponse
+	38, // 60: roveapi.Rove.LeaveTeam:output_type -> roveapi.LeaveTeamResponse
+	40, // 61: roveapi.Rove.TeamMessage:output_type -> roveapi.TeamMessageResponse
+	42, // 62: roveapi.Rove.Map:output_type -> roveapi.MapResponse
+	44, // 63: roveapi.Rove.SetVisibility:output_type -> roveapi.SetVisibilityResponse
+	47, // 64: roveapi.Rove.Spectate:output_type -> roveapi.SpectateResponse
+	47, // 65: roveapi.Rove.WatchSpectate:output_type -> roveapi.SpectateResponse
+	49, // [49:66] is the sub-list for method output_type
+	32, // [32:49] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
+}
+
+func init() { file_roveapi_roveapi_proto_init() }
+func file_roveapi_roveapi_proto_init() {
+	if File_roveapi_roveapi_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_roveapi_roveapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Command); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RadarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RadarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamRover); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Log); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstalledComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoverSpecifications); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InventoryItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoverStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roveapi_roveapi_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoverReadings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_roveapi_roveapi_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *StatusResponse) GetSpec() *RoverSpecifications {
-	if x != nil {
-		return x.Spec
-	}
-	return nil
-}
-
-func (x *StatusResponse) GetStatus() *RoverStatus {
-	if x != nil {
-		return x.Status
-	}
-	return nil
-}
-
-func (x *StatusResponse) GetReadings() *RoverReadings {
-	if x != nil {
-		return x.Readings
-	}
-	return nil
-}
-
-var File_roveapi_roveapi_proto protoreflect.FileDescriptor
-
-var file_roveapi_roveapi_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69,
-	0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
-	0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1b,
-	0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x25, 0x0a, 0x0f, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x35, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x2a, 0x0a, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65, 0x61,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a,
-	0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x6a,
-	0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a,
-	0x0c, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x0d, 0x52, 0x61, 0x64,
-	0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x0d, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x05,
-	0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x22, 0x3b, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2d, 0x0a,
-	0x03, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x24, 0x0a, 0x06,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x01, 0x79, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d,
-	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x72,
-	0x67, 0x65, 0x22, 0x82, 0x02, 0x0a, 0x0b, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x65,
-	0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x39,
-	0x0a, 0x0c, 0x73, 0x61, 0x69, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x61, 0x69, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x61, 0x69,
-	0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76,
-	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6e,
-	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x38, 0x0a,
-	0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x52, 0x6f, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x6f,
-	0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x42,
-	0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x04,
-	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x6f, 0x76,
-	0x65, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xa4,
-	0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x73,
-	0x70, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f,
-	0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f,
-	0x76, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x72, 0x65, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x2a, 0x85, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x74, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x10, 0x03, 0x12,
-	0x09, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x73, 0x68, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65,
-	0x70, 0x61, 0x69, 0x72, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63,
-	0x61, 0x73, 0x74, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65,
-	0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x10, 0x08,
-	0x12, 0x0b, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x10, 0x09, 0x2a, 0x83, 0x01,
-	0x0a, 0x07, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x65, 0x61,
-	0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x6f, 0x72, 0x74,
-	0x68, 0x45, 0x61, 0x73, 0x74, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x61, 0x73, 0x74, 0x10,
-	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x74, 0x68, 0x45, 0x61, 0x73, 0x74, 0x10, 0x04,
-	0x12, 0x09, 0x0a, 0x05, 0x53, 0x6f, 0x75, 0x74, 0x68, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x53,
-	0x6f, 0x75, 0x74, 0x68, 0x57, 0x65, 0x73, 0x74, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x65,
-	0x73, 0x74, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x57, 0x65, 0x73,
-	0x74, 0x10, 0x08, 0x2a, 0x69, 0x0a, 0x0c, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x61, 0x70, 0x61, 0x63,
-	0x69, 0x74, 0x79, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
-	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4d,
-	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x10, 0x04, 0x2a, 0x6a,
-	0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52,
-	0x6f, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x6f,
-	0x76, 0x65, 0x72, 0x44, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x74, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
-	0x52, 0x6f, 0x63, 0x6b, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x52,
-	0x6f, 0x63, 0x6b, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x6f,
-	0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x73, 0x10, 0x05, 0x2a, 0x37, 0x0a, 0x04, 0x54, 0x69,
-	0x6c, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x69, 0x6c, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x6f, 0x63, 0x6b, 0x10, 0x01, 0x12, 0x0a, 0x0a,
-	0x06, 0x47, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x61, 0x6e,
-	0x64, 0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x0c, 0x53, 0x61, 0x69, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x61,
-	0x69, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
-	0x43, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x10, 0x01, 0x12, 0x11,
-	0x0a, 0x0d, 0x53, 0x6f, 0x6c, 0x61, 0x72, 0x43, 0x68, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x10,
-	0x02, 0x32, 0xcf, 0x02, 0x0a, 0x04, 0x52, 0x6f, 0x76, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x76,
-	0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x17, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x05,
-	0x52, 0x61, 0x64, 0x61, 0x72, 0x12, 0x15, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72,
-	0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x61, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x6f, 0x76, 0x65, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6d, 0x64, 0x69, 0x6c, 0x75, 0x7a, 0x2f, 0x72, 0x6f, 0x76, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x6f, 0x76, 0x65, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_roveapi_roveapi_proto_rawDescOnce sync.Once
-	file_roveapi_roveapi_proto_rawDescData = file_roveapi_roveapi_proto_rawDesc
-)
-
-func file_roveapi_roveapi_proto_rawDescGZIP() []byte {
-	file_roveapi_roveapi_proto_rawDescOnce.Do(func() {
-		file_roveapi_roveapi_proto_rawDescData = protoimpl.X.CompressGZIP(file_roveapi_roveapi_proto_rawDescData)
-	})
-	return file_roveapi_roveapi_proto_rawDescData
-}
-
-var file_roveapi_roveapi_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_roveapi_roveapi_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_roveapi_roveapi_proto_goTypes = []interface{}{
-	(CommandType)(0),             // 0: roveapi.CommandType
-	(Bearing)(0),                 // 1: roveapi.Bearing
-	(RoverUpgrade)(0),            // 2: roveapi.RoverUpgrade
-	(Object)(0),                  // 3: roveapi.Object
-	(Tile)(0),                    // 4: roveapi.Tile
-	(SailPosition)(0),            // 5: roveapi.SailPosition
-	(*ServerStatusRequest)(nil),  // 6: roveapi.ServerStatusRequest
-	(*ServerStatusResponse)(nil), // 7: roveapi.ServerStatusResponse
-	(*RegisterRequest)(nil),      // 8: roveapi.RegisterRequest
-	(*Account)(nil),              // 9: roveapi.Account
-	(*RegisterResponse)(nil),     // 10: roveapi.RegisterResponse
-	(*Command)(nil),              // 11: roveapi.Command
-	(*CommandRequest)(nil),       // 12: roveapi.CommandRequest
-	(*CommandResponse)(nil),      // 13: roveapi.CommandResponse
-	(*RadarRequest)(nil),         // 14: roveapi.RadarRequest
-	(*RadarResponse)(nil),        // 15: roveapi.RadarResponse
-	(*StatusRequest)(nil),        // 16: roveapi.StatusRequest
-	(*Log)(nil),                  // 17: roveapi.Log
-	(*Vector)(nil),               // 18: roveapi.Vector
-	(*RoverSpecifications)(nil),  // 19: roveapi.RoverSpecifications
-	(*RoverStatus)(nil),          // 20: roveapi.RoverStatus
-	(*RoverReadings)(nil),        // 21: roveapi.RoverReadings
-	(*StatusResponse)(nil),       // 22: roveapi.StatusResponse
-}
-var file_roveapi_roveapi_proto_depIdxs = []int32{
-	9,  // 0: roveapi.RegisterResponse.account:type_name -> roveapi.Account
-	0,  // 1: roveapi.Command.command:type_name -> roveapi.CommandType
-	1,  // 2: roveapi.Command.bearing:type_name -> roveapi.Bearing
-	2,  // 3: roveapi.Command.upgrade:type_name -> roveapi.RoverUpgrade
-	9,  // 4: roveapi.CommandRequest.account:type_name -> roveapi.Account
-	11, // 5: roveapi.CommandRequest.commands:type_name -> roveapi.Command
-	9,  // 6: roveapi.RadarRequest.account:type_name -> roveapi.Account
-	4,  // 7: roveapi.RadarResponse.tiles:type_name -> roveapi.Tile
-	3,  // 8: roveapi.RadarResponse.objects:type_name -> roveapi.Object
-	9,  // 9: roveapi.StatusRequest.account:type_name -> roveapi.Account
-	1,  // 10: roveapi.RoverStatus.bearing:type_name -> roveapi.Bearing
-	5,  // 11: roveapi.RoverStatus.sailPosition:type_name -> roveapi.SailPosition
-	11, // 12: roveapi.RoverStatus.queuedCommands:type_name -> roveapi.Command
-	18, // 13: roveapi.RoverReadings.position:type_name -> roveapi.Vector
-	1,  // 14: roveapi.RoverReadings.wind:type_name -> roveapi.Bearing
-	17, // 15: roveapi.RoverReadings.logs:type_name -> roveapi.Log
-	19, // 16: roveapi.StatusResponse.spec:type_name -> roveapi.RoverSpecifications
-	20, // 17: roveapi.StatusResponse.status:type_name -> roveapi.RoverStatus
-	21, // 18: roveapi.StatusResponse.readings:type_name -> roveapi.RoverReadings
-	6,  // 19: roveapi.Rove.ServerStatus:input_type -> roveapi.ServerStatusRequest
-	8,  // 20: roveapi.Rove.Register:input_type -> roveapi.RegisterRequest
-	12, // 21: roveapi.Rove.Command:input_type -> roveapi.CommandRequest
-	14, // 22: roveapi.Rove.Radar:input_type -> roveapi.RadarRequest
-	16, // 23: roveapi.Rove.Status:input_type -> roveapi.StatusRequest
-	7,  // 24: roveapi.Rove.ServerStatus:output_type -> roveapi.ServerStatusResponse
-	10, // 25: roveapi.Rove.Register:output_type -> roveapi.RegisterResponse
-	13, // 26: roveapi.Rove.Command:output_type -> roveapi.CommandResponse
-	15, // 27: roveapi.Rove.Radar:output_type -> roveapi.RadarResponse
-	22, // 28: roveapi.Rove.Status:output_type -> roveapi.StatusResponse
-	24, // [24:29] is the sub-list for method output_type
-	19, // [19:24] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
-}
-
-func init() { file_roveapi_roveapi_proto_init() }
-func file_roveapi_roveapi_proto_init() {
-	if File_roveapi_roveapi_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_roveapi_roveapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerStatusRequest); i {
+		file_roveapi_roveapi_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1739,8 +3793,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerStatusResponse); i {
+		file_roveapi_roveapi_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1751,8 +3805,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterRequest); i {
+		file_roveapi_roveapi_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1763,8 +3817,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Account); i {
+		file_roveapi_roveapi_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTeamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1775,8 +3829,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_roveapi_roveapi_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinTeamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1787,8 +3841,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Command); i {
+		file_roveapi_roveapi_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1799,8 +3853,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandRequest); i {
+		file_roveapi_roveapi_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveTeamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1811,8 +3865,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandResponse); i {
+		file_roveapi_roveapi_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveTeamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1823,8 +3877,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RadarRequest); i {
+		file_roveapi_roveapi_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1835,8 +3889,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RadarResponse); i {
+		file_roveapi_roveapi_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1847,8 +3901,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusRequest); i {
+		file_roveapi_roveapi_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1859,8 +3913,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+		file_roveapi_roveapi_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1871,8 +3925,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vector); i {
+		file_roveapi_roveapi_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVisibilityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1883,8 +3937,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoverSpecifications); i {
+		file_roveapi_roveapi_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVisibilityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1895,8 +3949,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoverStatus); i {
+		file_roveapi_roveapi_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1907,8 +3961,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoverReadings); i {
+		file_roveapi_roveapi_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectatorRover); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1919,8 +3973,8 @@ func file_roveapi_roveapi_proto_init() {
 				return nil
 			}
 		}
-		file_roveapi_roveapi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+		file_roveapi_roveapi_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1938,7 +3992,7 @@ func file_roveapi_roveapi_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_roveapi_roveapi_proto_rawDesc,
 			NumEnums:      6,
-			NumMessages:   17,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1971,6 +4025,17 @@ type RoveClient interface {
 	// Register an account
 	// Tries to register an account with the given name
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// Log in to an account
+	// Responds with a short lived session token that can be used in place of the
+	// account secret
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Rotate an account secret
+	// Replaces the account secret with a newly generated one, the previous secret
+	// will no longer be accepted
+	RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error)
+	// Delete an account
+	// Deletes the account and leaves its rover dormant, freeing the account name
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
 	// Send commands to rover
 	// Sending commands to this endpoint will queue them to be executed during the
 	// following ticks, in the order sent. Commands sent within the same tick will
@@ -1982,61 +4047,222 @@ type RoveClient interface {
 	// Get rover information
 	// Gets information for the account's rover
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// Get the fleet
+	// Lists all the rovers owned by the account
+	Fleet(ctx context.Context, in *FleetRequest, opts ...grpc.CallOption) (*FleetResponse, error)
+	// Create a team
+	// Creates a new team with the account as its first member
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	// Join a team
+	// Adds the account to an existing team
+	JoinTeam(ctx context.Context, in *JoinTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	// Leave a team
+	// Removes the account from its current team
+	LeaveTeam(ctx context.Context, in *LeaveTeamRequest, opts ...grpc.CallOption) (*LeaveTeamResponse, error)
+	// Message the team
+	// Sends a message to the logs of every rover owned by the account's team
+	TeamMessage(ctx context.Context, in *TeamMessageRequest, opts ...grpc.CallOption) (*TeamMessageResponse, error)
+	// Get the explored map
+	// Gets everything explored by the account's team around a rover
+	Map(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*MapResponse, error)
+	// Set visibility to spectators
+	// Public accounts have their rovers shown to spectators when the server only
+	// shows the rovers of accounts that opt in
+	SetVisibility(ctx context.Context, in *SetVisibilityRequest, opts ...grpc.CallOption) (*SetVisibilityResponse, error)
+	// Spectate the world
+	// Gets a downsampled view of the generated world and the rovers spectators
+	// can see, servers may require a spectator token in the
+	// "rove-spectator-token" key
+	Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (*SpectateResponse, error)
+	// Watch the world
+	// Streams the same view as Spectate, straight away and then after every tick
+	WatchSpectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (Rove_WatchSpectateClient, error)
+}
+
+type roveClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoveClient(cc grpc.ClientConnInterface) RoveClient {
+	return &roveClient{cc}
+}
+
+func (c *roveClient) ServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (*ServerStatusResponse, error) {
+	out := new(ServerStatusResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/ServerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveClient) RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error) {
+	out := new(RotateSecretResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/RotateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveClient) Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/Command", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveClient) Radar(ctx context.Context, in *RadarRequest, opts ...grpc.CallOption) (*RadarResponse, error) {
+	out := new(RadarResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/Radar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type roveClient struct {
-	cc grpc.ClientConnInterface
+func (c *roveClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewRoveClient(cc grpc.ClientConnInterface) RoveClient {
-	return &roveClient{cc}
+func (c *roveClient) Fleet(ctx context.Context, in *FleetRequest, opts ...grpc.CallOption) (*FleetResponse, error) {
+	out := new(FleetResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/Fleet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *roveClient) ServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (*ServerStatusResponse, error) {
-	out := new(ServerStatusResponse)
-	err := c.cc.Invoke(ctx, "/roveapi.Rove/ServerStatus", in, out, opts...)
+func (c *roveClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/CreateTeam", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roveClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
-	out := new(RegisterResponse)
-	err := c.cc.Invoke(ctx, "/roveapi.Rove/Register", in, out, opts...)
+func (c *roveClient) JoinTeam(ctx context.Context, in *JoinTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/JoinTeam", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roveClient) Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
-	out := new(CommandResponse)
-	err := c.cc.Invoke(ctx, "/roveapi.Rove/Command", in, out, opts...)
+func (c *roveClient) LeaveTeam(ctx context.Context, in *LeaveTeamRequest, opts ...grpc.CallOption) (*LeaveTeamResponse, error) {
+	out := new(LeaveTeamResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/LeaveTeam", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roveClient) Radar(ctx context.Context, in *RadarRequest, opts ...grpc.CallOption) (*RadarResponse, error) {
-	out := new(RadarResponse)
-	err := c.cc.Invoke(ctx, "/roveapi.Rove/Radar", in, out, opts...)
+func (c *roveClient) TeamMessage(ctx context.Context, in *TeamMessageRequest, opts ...grpc.CallOption) (*TeamMessageResponse, error) {
+	out := new(TeamMessageResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/TeamMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roveClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
-	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/roveapi.Rove/Status", in, out, opts...)
+func (c *roveClient) Map(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*MapResponse, error) {
+	out := new(MapResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/Map", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveClient) SetVisibility(ctx context.Context, in *SetVisibilityRequest, opts ...grpc.CallOption) (*SetVisibilityResponse, error) {
+	out := new(SetVisibilityResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/SetVisibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roveClient) Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (*SpectateResponse, error) {
+	out := new(SpectateResponse)
+	err := c.cc.Invoke(ctx, "/roveapi.Rove/Spectate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *roveClient) WatchSpectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (Rove_WatchSpectateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Rove_serviceDesc.Streams[0], "/roveapi.Rove/WatchSpectate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &roveWatchSpectateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Rove_WatchSpectateClient interface {
+	Recv() (*SpectateResponse, error)
+	grpc.ClientStream
+}
+
+type roveWatchSpectateClient struct {
+	grpc.ClientStream
+}
+
+func (x *roveWatchSpectateClient) Recv() (*SpectateResponse, error) {
+	m := new(SpectateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RoveServer is the server API for Rove service.
 type RoveServer interface {
 	// Server status
@@ -2045,6 +4271,17 @@ type RoveServer interface {
 	// Register an account
 	// Tries to register an account with the given name
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// Log in to an account
+	// Responds with a short lived session token that can be used in place of the
+	// account secret
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Rotate an account secret
+	// Replaces the account secret with a newly generated one, the previous secret
+	// will no longer be accepted
+	RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error)
+	// Delete an account
+	// Deletes the account and leaves its rover dormant, freeing the account name
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
 	// Send commands to rover
 	// Sending commands to this endpoint will queue them to be executed during the
 	// following ticks, in the order sent. Commands sent within the same tick will
@@ -2056,6 +4293,36 @@ type RoveServer interface {
 	// Get rover information
 	// Gets information for the account's rover
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// Get the fleet
+	// Lists all the rovers owned by the account
+	Fleet(context.Context, *FleetRequest) (*FleetResponse, error)
+	// Create a team
+	// Creates a new team with the account as its first member
+	CreateTeam(context.Context, *CreateTeamRequest) (*TeamResponse, error)
+	// Join a team
+	// Adds the account to an existing team
+	JoinTeam(context.Context, *JoinTeamRequest) (*TeamResponse, error)
+	// Leave a team
+	// Removes the account from its current team
+	LeaveTeam(context.Context, *LeaveTeamRequest) (*LeaveTeamResponse, error)
+	// Message the team
+	// Sends a message to the logs of every rover owned by the account's team
+	TeamMessage(context.Context, *TeamMessageRequest) (*TeamMessageResponse, error)
+	// Get the explored map
+	// Gets everything explored by the account's team around a rover
+	Map(context.Context, *MapRequest) (*MapResponse, error)
+	// Set visibility to spectators
+	// Public accounts have their rovers shown to spectators when the server only
+	// shows the rovers of accounts that opt in
+	SetVisibility(context.Context, *SetVisibilityRequest) (*SetVisibilityResponse, error)
+	// Spectate the world
+	// Gets a downsampled view of the generated world and the rovers spectators
+	// can see, servers may require a spectator token in the
+	// "rove-spectator-token" key
+	Spectate(context.Context, *SpectateRequest) (*SpectateResponse, error)
+	// Watch the world
+	// Streams the same view as Spectate, straight away and then after every tick
+	WatchSpectate(*SpectateRequest, Rove_WatchSpectateServer) error
 }
 
 // UnimplementedRoveServer can be embedded to have forward compatible implementations.
@@ -2068,6 +4335,15 @@ func (*UnimplementedRoveServer) ServerStatus(context.Context, *ServerStatusReque
 func (*UnimplementedRoveServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (*UnimplementedRoveServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedRoveServer) RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSecret not implemented")
+}
+func (*UnimplementedRoveServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
 func (*UnimplementedRoveServer) Command(context.Context, *CommandRequest) (*CommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Command not implemented")
 }
@@ -2077,6 +4353,33 @@ func (*UnimplementedRoveServer) Radar(context.Context, *RadarRequest) (*RadarRes
 func (*UnimplementedRoveServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (*UnimplementedRoveServer) Fleet(context.Context, *FleetRequest) (*FleetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fleet not implemented")
+}
+func (*UnimplementedRoveServer) CreateTeam(context.Context, *CreateTeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (*UnimplementedRoveServer) JoinTeam(context.Context, *JoinTeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTeam not implemented")
+}
+func (*UnimplementedRoveServer) LeaveTeam(context.Context, *LeaveTeamRequest) (*LeaveTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveTeam not implemented")
+}
+func (*UnimplementedRoveServer) TeamMessage(context.Context, *TeamMessageRequest) (*TeamMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeamMessage not implemented")
+}
+func (*UnimplementedRoveServer) Map(context.Context, *MapRequest) (*MapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Map not implemented")
+}
+func (*UnimplementedRoveServer) SetVisibility(context.Context, *SetVisibilityRequest) (*SetVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVisibility not implemented")
+}
+func (*UnimplementedRoveServer) Spectate(context.Context, *SpectateRequest) (*SpectateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spectate not implemented")
+}
+func (*UnimplementedRoveServer) WatchSpectate(*SpectateRequest, Rove_WatchSpectateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSpectate not implemented")
+}
 
 func RegisterRoveServer(s *grpc.Server, srv RoveServer) {
 	s.RegisterService(&_Rove_serviceDesc, srv)
@@ -2118,6 +4421,60 @@ func _Rove_Register_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rove_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_RotateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).RotateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/RotateSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).RotateSecret(ctx, req.(*RotateSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Rove_Command_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CommandRequest)
 	if err := dec(in); err != nil {
@@ -2172,6 +4529,171 @@ func _Rove_Status_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rove_Fleet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FleetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).Fleet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/Fleet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).Fleet(ctx, req.(*FleetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/CreateTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_JoinTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).JoinTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/JoinTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).JoinTeam(ctx, req.(*JoinTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_LeaveTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).LeaveTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/LeaveTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).LeaveTeam(ctx, req.(*LeaveTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_TeamMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).TeamMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/TeamMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).TeamMessage(ctx, req.(*TeamMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_Map_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).Map(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/Map",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).Map(ctx, req.(*MapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_SetVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).SetVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/SetVisibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).SetVisibility(ctx, req.(*SetVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_Spectate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoveServer).Spectate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roveapi.Rove/Spectate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoveServer).Spectate(ctx, req.(*SpectateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rove_WatchSpectate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpectateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RoveServer).WatchSpectate(m, &roveWatchSpectateServer{stream})
+}
+
+type Rove_WatchSpectateServer interface {
+	Send(*SpectateResponse) error
+	grpc.ServerStream
+}
+
+type roveWatchSpectateServer struct {
+	grpc.ServerStream
+}
+
+func (x *roveWatchSpectateServer) Send(m *SpectateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Rove_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "roveapi.Rove",
 	HandlerType: (*RoveServer)(nil),
@@ -2184,6 +4706,18 @@ var _Rove_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Register",
 			Handler:    _Rove_Register_Handler,
 		},
+		{
+			MethodName: "Login",
+			Handler:    _Rove_Login_Handler,
+		},
+		{
+			MethodName: "RotateSecret",
+			Handler:    _Rove_RotateSecret_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _Rove_DeleteAccount_Handler,
+		},
 		{
 			MethodName: "Command",
 			Handler:    _Rove_Command_Handler,
@@ -2196,7 +4730,45 @@ var _Rove_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Rove_Status_Handler,
 		},
+		{
+			MethodName: "Fleet",
+			Handler:    _Rove_Fleet_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _Rove_CreateTeam_Handler,
+		},
+		{
+			MethodName: "JoinTeam",
+			Handler:    _Rove_JoinTeam_Handler,
+		},
+		{
+			MethodName: "LeaveTeam",
+			Handler:    _Rove_LeaveTeam_Handler,
+		},
+		{
+			MethodName: "TeamMessage",
+			Handler:    _Rove_TeamMessage_Handler,
+		},
+		{
+			MethodName: "Map",
+			Handler:    _Rove_Map_Handler,
+		},
+		{
+			MethodName: "SetVisibility",
+			Handler:    _Rove_SetVisibility_Handler,
+		},
+		{
+			MethodName: "Spectate",
+			Handler:    _Rove_Spectate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSpectate",
+			Handler:       _Rove_WatchSpectate_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "roveapi/roveapi.proto",
 }